@@ -8,6 +8,8 @@ replace github.com/goverland-labs/goverland-core-web-api/protocol => ./protocol
 
 require (
 	github.com/caarlos0/env/v10 v10.0.0
+	github.com/decred/dcrd/dcrec/secp256k1/v4 v4.3.0
+	github.com/golang-jwt/jwt/v5 v5.2.1
 	github.com/golang/protobuf v1.5.4
 	github.com/google/uuid v1.6.0
 	github.com/gorilla/handlers v1.5.1
@@ -17,11 +19,13 @@ require (
 	github.com/goverland-labs/goverland-core-web-api/protocol v0.0.0-20250220134513-ce50ab1484b8
 	github.com/grpc-ecosystem/go-grpc-middleware v1.4.0
 	github.com/grpc-ecosystem/go-grpc-prometheus v1.2.0
+	github.com/grpc-ecosystem/grpc-gateway/v2 v2.26.0
 	github.com/prometheus/client_golang v1.14.0
 	github.com/rs/zerolog v1.29.0
 	github.com/s-larionov/process-manager v0.0.1
 	github.com/shopspring/decimal v1.3.1
 	go.openly.dev/pointy v1.3.0
+	golang.org/x/crypto v0.32.0
 	google.golang.org/genproto/googleapis/rpc v0.0.0-20250115164207-1a7da9e5054f
 	google.golang.org/grpc v1.70.0
 	google.golang.org/protobuf v1.36.3
@@ -41,4 +45,5 @@ require (
 	golang.org/x/net v0.34.0 // indirect
 	golang.org/x/sys v0.29.0 // indirect
 	golang.org/x/text v0.21.0 // indirect
+	google.golang.org/genproto/googleapis/api v0.0.0-20250115164207-1a7da9e5054f // indirect
 )
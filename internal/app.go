@@ -1,7 +1,9 @@
 package internal
 
 import (
+	"context"
 	"fmt"
+	"net"
 	"os"
 	"os/signal"
 	"syscall"
@@ -21,12 +23,16 @@ import (
 	ingrpc "github.com/goverland-labs/goverland-core-web-api/internal/grpc"
 	ihelpers "github.com/goverland-labs/goverland-core-web-api/internal/helpers"
 	"github.com/goverland-labs/goverland-core-web-api/internal/rest"
+	"github.com/goverland-labs/goverland-core-web-api/internal/rest/auth"
 	apihandlers "github.com/goverland-labs/goverland-core-web-api/internal/rest/handlers"
 	"github.com/goverland-labs/goverland-core-web-api/pkg/grpcsrv"
 	"github.com/goverland-labs/goverland-core-web-api/pkg/health"
 	"github.com/goverland-labs/goverland-core-web-api/pkg/prometheus"
 )
 
+// minSubscriberTokenSecretLength is the key size of HS256.
+const minSubscriberTokenSecretLength = 32
+
 type Application struct {
 	sigChan <-chan os.Signal
 	manager *process.Manager
@@ -36,6 +42,7 @@ type Application struct {
 	cpc  storagepb.ProposalClient
 	cefc feedpb.FeedEventsClient
 	csfc storagepb.VoteClient
+	ens  *ihelpers.EnsEnricher
 }
 
 func NewApplication(cfg config.App) (*Application, error) {
@@ -101,10 +108,33 @@ func (a *Application) initRestAPI() error {
 	a.cpc = storagepb.NewProposalClient(storageConn)
 	a.csfc = storagepb.NewVoteClient(storageConn)
 	vc := storagepb.NewVoteClient(storageConn)
-	ec := storagepb.NewEnsClient(storageConn)
+	ec := ihelpers.NewEnsCache(storagepb.NewEnsClient(storageConn), ihelpers.EnsCacheOptions{
+		ForwardTTL:   a.cfg.EnsCache.ForwardTTL,
+		ReverseTTL:   a.cfg.EnsCache.ReverseTTL,
+		NegativeTTL:  a.cfg.EnsCache.NegativeTTL,
+		BatchWindow:  a.cfg.EnsCache.BatchWindow,
+		MaxEntries:   a.cfg.EnsCache.MaxEntries,
+		MaxBatchSize: a.cfg.EnsCache.MaxBatchSize,
+	})
+	a.ens = ihelpers.NewEnsEnricher(ec)
 	sc := storagepb.NewStatsClient(storageConn)
 	delegateClient := storagepb.NewDelegateClient(storageConn)
 	resolver := ihelpers.NewIdentifierResolver(ec)
+	webhookURLs := ihelpers.NewWebhookURLValidator(ihelpers.WebhookURLPolicy{
+		HTTPSOnly:    a.cfg.Webhook.HTTPSOnly,
+		AllowedPorts: a.cfg.Webhook.AllowedPorts,
+		MaxLength:    a.cfg.Webhook.MaxURLLength,
+	}, net.DefaultResolver)
+
+	if len(a.cfg.SubscriberToken.Secret) < minSubscriberTokenSecretLength {
+		return fmt.Errorf("subscriber token secret should contain at least %d bytes", minSubscriberTokenSecretLength)
+	}
+	tokens := auth.NewTokens(
+		[]byte(a.cfg.SubscriberToken.Secret),
+		a.cfg.SubscriberToken.TTL,
+		a.cfg.SubscriberToken.RefreshTTL,
+		a.cfg.SubscriberToken.AcceptRawID,
+	)
 
 	feedConn, err := grpc.NewClient(a.cfg.InternalAPI.CoreFeedAddress, grpc.WithTransportCredentials(insecure.NewCredentials()))
 	if err != nil {
@@ -117,17 +147,23 @@ func (a *Application) initRestAPI() error {
 	a.cefc = feedpb.NewFeedEventsClient(feedConn)
 
 	handlers := []apihandlers.APIHandler{
-		apihandlers.NewDaoHandler(a.cdc, fc, delegateClient),
-		apihandlers.NewProposalHandler(a.cpc, vc),
-		apihandlers.NewSubscribeHandler(subscriberClient, subscriptionClient),
-		apihandlers.NewFeedHandler(fc),
-		apihandlers.NewVotesHandler(vc, resolver),
+		apihandlers.NewDaoHandler(a.cdc, a.cpc, fc, delegateClient, resolver, a.ens, a.cfg.REST.PublicBaseURL),
+		apihandlers.NewProposalHandler(a.cpc, vc, a.cdc, resolver, a.ens, a.cfg.REST.PreparedVoteTTL),
+		apihandlers.NewSubscribeHandler(subscriberClient, subscriptionClient, webhookURLs, tokens),
+		apihandlers.NewFeedHandler(fc, a.cfg.REST.PublicBaseURL),
+		apihandlers.NewVotesHandler(vc, a.cdc, a.cpc, resolver, a.ens),
 		apihandlers.NewEnsHandler(ec),
 		apihandlers.NewStatsHandler(sc),
-		apihandlers.NewDelegateHandler(delegateClient, resolver),
+		apihandlers.NewDelegateHandler(delegateClient, resolver, a.ens),
+		apihandlers.NewUserHandler(vc, a.cdc, a.cpc, delegateClient, ec, resolver),
+	}
+
+	gateway, err := rest.NewGateway(context.Background(), ingrpc.NewDaoServer(a.cdc), ingrpc.NewProposalServer(a.cpc, a.ens))
+	if err != nil {
+		return fmt.Errorf("create grpc gateway: %w", err)
 	}
 
-	a.manager.AddWorker(process.NewServerWorker("rest-API", rest.NewRestServer(a.cfg.REST, handlers)))
+	a.manager.AddWorker(process.NewServerWorker("rest-API", rest.NewRestServer(a.cfg.REST, handlers, gateway)))
 
 	return nil
 }
@@ -166,8 +202,8 @@ func (a *Application) initGRPCServer() error {
 	)
 
 	instopb.RegisterDaoServer(srv, ingrpc.NewDaoServer(a.cdc))
-	instopb.RegisterProposalServer(srv, ingrpc.NewProposalServer(a.cpc))
-	infeedpb.RegisterFeedEventsServer(srv, ingrpc.NewFeedServer(ingrpc.NewService(a.cefc, a.csfc)))
+	instopb.RegisterProposalServer(srv, ingrpc.NewProposalServer(a.cpc, a.ens))
+	infeedpb.RegisterFeedEventsServer(srv, ingrpc.NewFeedServer(ingrpc.NewService(a.cefc, a.csfc, a.ens)))
 
 	a.manager.AddWorker(grpcsrv.NewGrpcServerWorker("API", srv, a.cfg.InternalAPI.Bind))
 
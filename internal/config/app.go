@@ -6,4 +6,8 @@ type App struct {
 	Health      Health
 	REST        REST
 	InternalAPI InternalAPI
+	Webhook     Webhook
+	EnsCache    EnsCache
+
+	SubscriberToken SubscriberToken
 }
@@ -0,0 +1,13 @@
+package config
+
+import "time"
+
+type EnsCache struct {
+	ForwardTTL  time.Duration `env:"ENS_CACHE_FORWARD_TTL" envDefault:"1h"`
+	ReverseTTL  time.Duration `env:"ENS_CACHE_REVERSE_TTL" envDefault:"1h"`
+	NegativeTTL time.Duration `env:"ENS_CACHE_NEGATIVE_TTL" envDefault:"1m"`
+	BatchWindow time.Duration `env:"ENS_CACHE_BATCH_WINDOW" envDefault:"5ms"`
+	// MaxEntries limits each of the forward, reverse and negative caches
+	MaxEntries   int `env:"ENS_CACHE_MAX_ENTRIES" envDefault:"100000"`
+	MaxBatchSize int `env:"ENS_CACHE_MAX_BATCH_SIZE" envDefault:"100"`
+}
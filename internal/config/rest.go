@@ -10,5 +10,20 @@ type REST struct {
 	WriteTimeout  time.Duration `env:"REST_WRITE_TIMEOUT" envDefault:"300s"`
 	HandleTimeout time.Duration `env:"REST_HANDLE_TIMEOUT" envDefault:"300s"`
 
-	PingDelay time.Duration `json:"REST_PING_DELAY" envDefault:"30s"`
+	PingDelay time.Duration `json:"REST_PING_DELAY" envDefault:"10s"`
+
+	BatchMaxRequests int           `env:"REST_BATCH_MAX_REQUESTS" envDefault:"20"`
+	BatchTimeout     time.Duration `env:"REST_BATCH_TIMEOUT" envDefault:"10s"`
+
+	PreparedVoteTTL time.Duration `env:"REST_PREPARED_VOTE_TTL" envDefault:"10m"`
+	IdempotencyTTL  time.Duration `env:"REST_IDEMPOTENCY_TTL" envDefault:"24h"`
+	// IdempotencyMaxKeys is the max number of the stored responses, the oldest ones are dropped first
+	IdempotencyMaxKeys int `env:"REST_IDEMPOTENCY_MAX_KEYS" envDefault:"100000"`
+
+	// PublicBaseURL is the scheme and host the API is served at for clients, syndication feeds link to themselves with it
+	PublicBaseURL string `env:"REST_PUBLIC_BASE_URL"`
+
+	// DocsScriptIntegrity is the SRI hash of the bundle at DocsScriptURL, the docs page is not served without it
+	DocsScriptURL       string `env:"REST_DOCS_SCRIPT_URL" envDefault:"https://cdn.redoc.ly/redoc/v2.1.5/bundles/redoc.standalone.js"`
+	DocsScriptIntegrity string `env:"REST_DOCS_SCRIPT_INTEGRITY"`
 }
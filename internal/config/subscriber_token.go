@@ -0,0 +1,11 @@
+package config
+
+import "time"
+
+type SubscriberToken struct {
+	Secret     string        `env:"SUBSCRIBER_TOKEN_SECRET"`
+	TTL        time.Duration `env:"SUBSCRIBER_TOKEN_TTL" envDefault:"15m"`
+	RefreshTTL time.Duration `env:"SUBSCRIBER_TOKEN_REFRESH_TTL" envDefault:"720h"`
+	// AcceptRawID keeps the subscribers created before the tokens working until they exchange the IDs for tokens
+	AcceptRawID bool `env:"SUBSCRIBER_TOKEN_ACCEPT_RAW_ID" envDefault:"true"`
+}
@@ -0,0 +1,7 @@
+package config
+
+type Webhook struct {
+	HTTPSOnly    bool  `env:"WEBHOOK_HTTPS_ONLY" envDefault:"false"`
+	AllowedPorts []int `env:"WEBHOOK_ALLOWED_PORTS" envDefault:"80,443,8080,8443"`
+	MaxURLLength int   `env:"WEBHOOK_MAX_URL_LENGTH" envDefault:"2048"`
+}
@@ -5,7 +5,10 @@ import (
 	"time"
 
 	coredata "github.com/goverland-labs/goverland-core-storage/protocol/storagepb"
+	"github.com/goverland-labs/goverland-core-web-api/internal/helpers"
+	"github.com/goverland-labs/goverland-core-web-api/pkg/address"
 	internalpb "github.com/goverland-labs/goverland-core-web-api/protocol/storage"
+	"github.com/rs/zerolog/log"
 	"go.openly.dev/pointy"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -13,12 +16,14 @@ import (
 type ProposalServer struct {
 	internalpb.UnimplementedProposalServer
 
-	pc coredata.ProposalClient
+	pc  coredata.ProposalClient
+	ens *helpers.EnsEnricher
 }
 
-func NewProposalServer(pc coredata.ProposalClient) *ProposalServer {
+func NewProposalServer(pc coredata.ProposalClient, ens *helpers.EnsEnricher) *ProposalServer {
 	return &ProposalServer{
-		pc: pc,
+		pc:  pc,
+		ens: ens,
 	}
 }
 
@@ -30,11 +35,28 @@ func (s *ProposalServer) GetByID(ctx context.Context, req *internalpb.ProposalBy
 		return nil, err
 	}
 
+	proposal := convertProposal(pr.GetProposal())
+	s.enrichProposals(ctx, proposal)
+
 	return &internalpb.ProposalByIDResponse{
-		Proposal: convertProposal(pr.GetProposal()),
+		Proposal: proposal,
 	}, nil
 }
 
+// enrichProposals fills the missing ENS names of the authors, the proposals are returned as is when the lookup fails.
+func (s *ProposalServer) enrichProposals(ctx context.Context, list ...*internalpb.ProposalInfo) {
+	names := helpers.NewEnsNames()
+	for _, info := range list {
+		if info != nil {
+			names.Add(info.GetAuthor(), &info.AuthorEnsName)
+		}
+	}
+
+	if err := s.ens.Enrich(ctx, names); err != nil {
+		log.Warn().Err(err).Msg("enrich proposals with ens names")
+	}
+}
+
 func convertProposal(pr *coredata.ProposalInfo) *internalpb.ProposalInfo {
 	if pr == nil {
 		return nil
@@ -52,7 +74,8 @@ func convertProposal(pr *coredata.ProposalInfo) *internalpb.ProposalInfo {
 		Id:                pr.GetId(),
 		CreatedAt:         pr.GetCreatedAt(),
 		UpdatedAt:         pr.GetUpdatedAt(),
-		Author:            pr.GetAuthor(),
+		Author:            address.Checksum(pr.GetAuthor()),
+		AuthorEnsName:     pr.GetEnsName(),
 		DaoId:             pr.GetDaoId(),
 		Title:             pr.GetTitle(),
 		State:             pr.GetState(),
@@ -111,6 +134,7 @@ func (s *ProposalServer) GetByFilter(ctx context.Context, req *internalpb.Propos
 	for _, info := range resp.Proposals {
 		result.Proposals = append(result.Proposals, convertProposal(info))
 	}
+	s.enrichProposals(ctx, result.Proposals...)
 
 	for _, info := range resp.ProposalsShort {
 		result.ProposalsShort = append(result.ProposalsShort, convertShortProposal(info))
@@ -10,14 +10,21 @@ import (
 
 	feedproto "github.com/goverland-labs/goverland-core-feed/protocol/feedpb"
 	coreproto "github.com/goverland-labs/goverland-core-storage/protocol/storagepb"
+	"github.com/goverland-labs/goverland-core-web-api/internal/helpers"
+	"github.com/goverland-labs/goverland-core-web-api/pkg/address"
 	internalproto "github.com/goverland-labs/goverland-core-web-api/protocol/feed"
+	"github.com/rs/zerolog/log"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+// enrichChunkSize is the max number of stream items enriched with ENS names by one lookup
+const enrichChunkSize = 100
+
 type Service struct {
 	coreFeed    feedproto.FeedEventsClient
 	coreStorage coreproto.VoteClient
+	ens         *helpers.EnsEnricher
 }
 
 type ItemsRequest struct {
@@ -33,10 +40,11 @@ type Result struct {
 	Err  error
 }
 
-func NewService(fc feedproto.FeedEventsClient, cv coreproto.VoteClient) *Service {
+func NewService(fc feedproto.FeedEventsClient, cv coreproto.VoteClient, ens *helpers.EnsEnricher) *Service {
 	return &Service{
 		coreFeed:    fc,
 		coreStorage: cv,
+		ens:         ens,
 	}
 }
 
@@ -66,6 +74,13 @@ func (s *Service) GetFeedItems(ctx context.Context, req ItemsRequest) <-chan Res
 			return
 		}
 
+		items := make(chan Result, enrichChunkSize)
+		done := s.forwardEnriched(ctx, items, ch)
+		defer func() {
+			close(items)
+			<-done
+		}()
+
 		for {
 			in, err := stream.Recv()
 			if errors.Is(err, io.EOF) {
@@ -73,10 +88,10 @@ func (s *Service) GetFeedItems(ctx context.Context, req ItemsRequest) <-chan Res
 			}
 
 			if err != nil {
-				ch <- Result{Err: fmt.Errorf("stream.Recv: %w", err)}
+				items <- Result{Err: fmt.Errorf("stream.Recv: %w", err)}
 			}
 
-			ch <- Result{Item: convertFeedToItem(in)}
+			items <- Result{Item: convertFeedToItem(in)}
 		}
 	}()
 
@@ -93,6 +108,13 @@ func (s *Service) GetFeedItems(ctx context.Context, req ItemsRequest) <-chan Res
 				return
 			}
 
+			items := make(chan Result, enrichChunkSize)
+			done := s.forwardEnriched(ctx, items, ch)
+			defer func() {
+				close(items)
+				<-done
+			}()
+
 			for {
 				in, err := stream.Recv()
 				if errors.Is(err, io.EOF) {
@@ -100,10 +122,10 @@ func (s *Service) GetFeedItems(ctx context.Context, req ItemsRequest) <-chan Res
 				}
 
 				if err != nil {
-					ch <- Result{Err: fmt.Errorf("stream.Recv: %w", err)}
+					items <- Result{Err: fmt.Errorf("stream.Recv: %w", err)}
 				}
 
-				ch <- Result{Item: convertVoteToInternal(in)}
+				items <- Result{Item: convertVoteToInternal(in)}
 			}
 		}()
 	}
@@ -111,6 +133,87 @@ func (s *Service) GetFeedItems(ctx context.Context, req ItemsRequest) <-chan Res
 	return ch
 }
 
+// forwardEnriched sends the results to out in the order they are received. The items already waiting in the channel
+// are enriched with ENS names by one lookup, so a backlog of the stream is not delayed by a lookup per item, and
+// a single item is sent as soon as nothing else is waiting. The returned channel is closed when in is drained.
+func (s *Service) forwardEnriched(ctx context.Context, in <-chan Result, out chan<- Result) <-chan struct{} {
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+
+		chunk := make([]Result, 0, enrichChunkSize)
+		flush := func() {
+			s.enrichItems(ctx, chunk)
+			for _, res := range chunk {
+				out <- res
+			}
+			chunk = chunk[:0]
+		}
+
+		for {
+			var (
+				res Result
+				ok  bool
+			)
+			select {
+			case res, ok = <-in:
+			default:
+				if len(chunk) > 0 {
+					flush()
+
+					continue
+				}
+				res, ok = <-in
+			}
+			if !ok {
+				flush()
+
+				return
+			}
+
+			chunk = append(chunk, res)
+			if len(chunk) == enrichChunkSize {
+				flush()
+			}
+		}
+	}()
+
+	return done
+}
+
+// enrichItems fills the missing ENS names of the item addresses, the items are sent as is when the lookup fails.
+func (s *Service) enrichItems(ctx context.Context, chunk []Result) {
+	names := helpers.NewEnsNames()
+	for _, res := range chunk {
+		if res.Item != nil {
+			addItemEnsNames(names, res.Item)
+		}
+	}
+
+	if err := s.ens.Enrich(ctx, names); err != nil {
+		log.Warn().Err(err).Msg("enrich feed items with ens names")
+	}
+}
+
+func addItemEnsNames(names *helpers.EnsNames, item *internalproto.FeedItem) {
+	switch snapshot := item.GetSnapshot().(type) {
+	case *internalproto.FeedItem_Proposal:
+		if snapshot != nil && snapshot.Proposal != nil {
+			names.Add(snapshot.Proposal.GetAuthor(), &snapshot.Proposal.AuthorEnsName)
+		}
+	case *internalproto.FeedItem_Delegate:
+		if snapshot != nil && snapshot.Delegate != nil {
+			names.Add(snapshot.Delegate.GetAddressFrom(), &snapshot.Delegate.AddressFromEnsName)
+			names.Add(snapshot.Delegate.GetAddressTo(), &snapshot.Delegate.AddressToEnsName)
+		}
+	case *internalproto.FeedItem_Vote:
+		if snapshot != nil && snapshot.Vote != nil {
+			names.Add(snapshot.Vote.GetVoterAddress(), &snapshot.Vote.VoterEnsName)
+		}
+	}
+}
+
 func convertTypesToFeedProto(list []internalproto.FeedItemType) []feedproto.FeedItemType {
 	res := make([]feedproto.FeedItemType, 0, len(list))
 	for _, item := range list {
@@ -176,7 +279,7 @@ func convertFeedProposal(in *feedproto.FeedItem_Proposal) *internalproto.FeedIte
 			CreatedAt:         in.Proposal.GetCreatedAt(),
 			Id:                in.Proposal.GetId(),
 			DaoInternalId:     in.Proposal.GetDaoInternalId(),
-			Author:            in.Proposal.GetAuthor(),
+			Author:            address.Checksum(in.Proposal.GetAuthor()),
 			Title:             in.Proposal.GetTitle(),
 			State:             in.Proposal.GetState(),
 			Spam:              in.Proposal.GetSpam(),
@@ -198,8 +301,8 @@ func convertFeedDelegate(in *feedproto.FeedItem_Delegate) *internalproto.FeedIte
 
 	return &internalproto.FeedItem_Delegate{
 		Delegate: &internalproto.Delegate{
-			AddressFrom:   in.Delegate.GetAddressFrom(),
-			AddressTo:     in.Delegate.GetAddressTo(),
+			AddressFrom:   address.Checksum(in.Delegate.GetAddressFrom()),
+			AddressTo:     address.Checksum(in.Delegate.GetAddressTo()),
 			DaoInternalId: in.Delegate.GetDaoInternalId(),
 			ProposalId:    in.Delegate.GetProposalId(),
 			Action:        in.Delegate.GetAction(),
@@ -233,7 +336,7 @@ func convertVoteToInternal(in *coreproto.VoteInfo) *internalproto.FeedItem {
 				CreatedAt:     timestamppb.New(time.Unix(int64(in.GetCreated()), 0)),
 				DaoInternalId: in.GetDaoId(),
 				ProposalId:    in.GetProposalId(),
-				VoterAddress:  in.GetVoter(),
+				VoterAddress:  address.Checksum(in.GetVoter()),
 				VoteId:        in.GetId(),
 				Choice:        in.GetChoice(),
 				Reason:        in.GetReason(),
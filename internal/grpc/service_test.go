@@ -0,0 +1,56 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	coreproto "github.com/goverland-labs/goverland-core-storage/protocol/storagepb"
+	"google.golang.org/grpc"
+
+	"github.com/goverland-labs/goverland-core-web-api/internal/helpers"
+)
+
+type ensClientMock struct {
+	coreproto.EnsClient
+
+	calls int
+}
+
+func (m *ensClientMock) GetEnsByAddresses(_ context.Context, in *coreproto.EnsByAddressesRequest, _ ...grpc.CallOption) (*coreproto.EnsByAddressesResponse, error) {
+	m.calls++
+	resp := &coreproto.EnsByAddressesResponse{}
+	for _, addr := range in.GetAddresses() {
+		resp.EnsNames = append(resp.EnsNames, &coreproto.EnsName{Address: addr, Name: addr + ".eth"})
+	}
+
+	return resp, nil
+}
+
+func TestService_ForwardEnriched(t *testing.T) {
+	ec := &ensClientMock{}
+	s := NewService(nil, nil, helpers.NewEnsEnricher(ec))
+
+	// the backlog is waiting in the channel and is enriched by one lookup
+	in := make(chan Result, 5)
+	for i := 0; i < 5; i++ {
+		in <- Result{Item: convertVoteToInternal(&coreproto.VoteInfo{Id: fmt.Sprint(i), Voter: fmt.Sprintf("0x%040d", i)})}
+	}
+	close(in)
+
+	out := make(chan Result, 5)
+	<-s.forwardEnriched(context.Background(), in, out)
+	close(out)
+
+	i := 0
+	for res := range out {
+		vote := res.Item.GetVote()
+		if vote.GetVoteId() != fmt.Sprint(i) || vote.GetVoterEnsName() != vote.GetVoterAddress()+".eth" {
+			t.Errorf("item %d: vote %s with ens name %q", i, vote.GetVoteId(), vote.GetVoterEnsName())
+		}
+		i++
+	}
+	if i != 5 || ec.calls != 1 {
+		t.Errorf("got %d items with %d lookups, want 5 items with 1 lookup", i, ec.calls)
+	}
+}
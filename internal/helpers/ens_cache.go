@@ -0,0 +1,332 @@
+package helpers
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/goverland-labs/goverland-core-storage/protocol/storagepb"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc"
+
+	"github.com/goverland-labs/goverland-core-web-api/pkg/ttlcache"
+)
+
+const (
+	ensLookupForward = "forward"
+	ensLookupReverse = "reverse"
+
+	ensResultHit         = "hit"
+	ensResultNegativeHit = "negative_hit"
+	ensResultMiss        = "miss"
+
+	// ensFetchTimeout limits the upstream call of a batch, it is not bound to the contexts of the waiting requests.
+	ensFetchTimeout = 5 * time.Second
+)
+
+var (
+	ensLookupsCounter  *prometheus.CounterVec
+	ensUpstreamCounter *prometheus.CounterVec
+	ensBatchSize       *prometheus.HistogramVec
+)
+
+func init() {
+	ensLookupsCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "ens_cache_lookups_total",
+		Help: "How many ENS lookups were served, partitioned by direction and cache result.",
+	}, []string{"direction", "result"})
+	ensUpstreamCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "ens_cache_upstream_requests_total",
+		Help: "How many batched ENS requests were sent to the storage, partitioned by direction.",
+	}, []string{"direction"})
+	ensBatchSize = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "ens_cache_upstream_batch_size",
+		Help:    "Number of names or addresses in the batched ENS requests.",
+		Buckets: []float64{1, 2, 5, 10, 25, 50, 100},
+	}, []string{"direction"})
+
+	for name, collector := range map[string]prometheus.Collector{
+		"ens_cache_lookups_total":           ensLookupsCounter,
+		"ens_cache_upstream_requests_total": ensUpstreamCounter,
+		"ens_cache_upstream_batch_size":     ensBatchSize,
+	} {
+		if err := prometheus.Register(collector); err != nil {
+			log.Error().Err(err).
+				Fields(map[string]string{"metric": name}).
+				Msg("unable to register prometheus metric")
+		}
+	}
+}
+
+type EnsCacheOptions struct {
+	// ForwardTTL keeps the addresses of resolved names
+	ForwardTTL time.Duration
+	// ReverseTTL keeps the names of resolved addresses
+	ReverseTTL time.Duration
+	// NegativeTTL keeps the names and addresses unknown to the storage
+	NegativeTTL time.Duration
+	// BatchWindow is the time lookups are collected for before the single upstream request is sent
+	BatchWindow time.Duration
+	// MaxEntries limits every cache, the oldest entries are evicted first, zero means no limit
+	MaxEntries int
+	// MaxBatchSize limits the names or addresses of the upstream request, full batches are sent at once
+	MaxBatchSize int
+}
+
+type ensEntry struct {
+	address string
+	name    string
+}
+
+// EnsCache is the storagepb.EnsClient caching forward and reverse lookups. Lookups missing in the cache
+// are collected for the batch window and loaded by a single upstream request.
+type EnsCache struct {
+	storagepb.EnsClient
+
+	forward       *ttlcache.Cache[string, ensEntry]
+	reverse       *ttlcache.Cache[string, ensEntry]
+	unknownNames  *ttlcache.Cache[string, struct{}]
+	unknownAddrs  *ttlcache.Cache[string, struct{}]
+	forwardLoader *ensBatcher
+	reverseLoader *ensBatcher
+}
+
+func NewEnsCache(client storagepb.EnsClient, opts EnsCacheOptions) *EnsCache {
+	c := &EnsCache{
+		EnsClient:    client,
+		forward:      ttlcache.NewBounded[string, ensEntry](opts.ForwardTTL, opts.MaxEntries),
+		reverse:      ttlcache.NewBounded[string, ensEntry](opts.ReverseTTL, opts.MaxEntries),
+		unknownNames: ttlcache.NewBounded[string, struct{}](opts.NegativeTTL, opts.MaxEntries),
+		unknownAddrs: ttlcache.NewBounded[string, struct{}](opts.NegativeTTL, opts.MaxEntries),
+	}
+	c.forwardLoader = newEnsBatcher(ensLookupForward, opts.BatchWindow, opts.MaxBatchSize, c.fetchAddresses)
+	c.reverseLoader = newEnsBatcher(ensLookupReverse, opts.BatchWindow, opts.MaxBatchSize, c.fetchNames)
+
+	return c
+}
+
+func (c *EnsCache) GetAddressesByEnsNames(ctx context.Context, in *storagepb.AddressesByEnsNamesRequest, _ ...grpc.CallOption) (*storagepb.AddressesByEnsNamesResponse, error) {
+	entries, err := c.lookup(ctx, ensLookupForward, in.GetNames(), c.forward, c.unknownNames, c.forwardLoader)
+	if err != nil {
+		return nil, err
+	}
+
+	return &storagepb.AddressesByEnsNamesResponse{EnsNames: entries}, nil
+}
+
+func (c *EnsCache) GetEnsByAddresses(ctx context.Context, in *storagepb.EnsByAddressesRequest, _ ...grpc.CallOption) (*storagepb.EnsByAddressesResponse, error) {
+	entries, err := c.lookup(ctx, ensLookupReverse, in.GetAddresses(), c.reverse, c.unknownAddrs, c.reverseLoader)
+	if err != nil {
+		return nil, err
+	}
+
+	return &storagepb.EnsByAddressesResponse{EnsNames: entries}, nil
+}
+
+// lookup returns the entries in the order of the values, unknown values are skipped as the storage does.
+func (c *EnsCache) lookup(
+	ctx context.Context,
+	direction string,
+	values []string,
+	known *ttlcache.Cache[string, ensEntry],
+	unknown *ttlcache.Cache[string, struct{}],
+	loader *ensBatcher,
+) ([]*storagepb.EnsName, error) {
+	found := make(map[string]ensEntry, len(values))
+	misses := make(map[string]string)
+	for _, value := range values {
+		key := strings.ToLower(value)
+		if entry, ok := known.Get(key); ok {
+			found[key] = entry
+			ensLookupsCounter.WithLabelValues(direction, ensResultHit).Inc()
+
+			continue
+		}
+		if _, ok := unknown.Get(key); ok {
+			ensLookupsCounter.WithLabelValues(direction, ensResultNegativeHit).Inc()
+
+			continue
+		}
+
+		misses[key] = value
+		ensLookupsCounter.WithLabelValues(direction, ensResultMiss).Inc()
+	}
+
+	if len(misses) > 0 {
+		loaded, err := loader.load(ctx, misses)
+		if err != nil {
+			return nil, err
+		}
+		for key := range misses {
+			if entry, ok := loaded[key]; ok {
+				found[key] = entry
+			}
+		}
+	}
+
+	result := make([]*storagepb.EnsName, 0, len(found))
+	for _, value := range values {
+		entry, ok := found[strings.ToLower(value)]
+		if !ok {
+			continue
+		}
+		result = append(result, &storagepb.EnsName{Address: entry.address, Name: entry.name})
+	}
+
+	return result, nil
+}
+
+func (c *EnsCache) fetchAddresses(ctx context.Context, names map[string]string) (map[string]ensEntry, error) {
+	list := make([]string, 0, len(names))
+	for key := range names {
+		list = append(list, key)
+	}
+
+	resp, err := c.EnsClient.GetAddressesByEnsNames(ctx, &storagepb.AddressesByEnsNamesRequest{Names: list})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]ensEntry, len(resp.GetEnsNames()))
+	for _, info := range resp.GetEnsNames() {
+		if info.GetAddress() == "" {
+			continue
+		}
+		key := strings.ToLower(info.GetName())
+		result[key] = ensEntry{address: info.GetAddress(), name: info.GetName()}
+		c.forward.Set(key, result[key])
+	}
+	for key := range names {
+		if _, ok := result[key]; !ok {
+			c.unknownNames.Set(key, struct{}{})
+		}
+	}
+
+	return result, nil
+}
+
+func (c *EnsCache) fetchNames(ctx context.Context, addresses map[string]string) (map[string]ensEntry, error) {
+	list := make([]string, 0, len(addresses))
+	for _, address := range addresses {
+		list = append(list, address)
+	}
+
+	resp, err := c.EnsClient.GetEnsByAddresses(ctx, &storagepb.EnsByAddressesRequest{Addresses: list})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]ensEntry, len(resp.GetEnsNames()))
+	for _, info := range resp.GetEnsNames() {
+		if info.GetName() == "" {
+			continue
+		}
+		key := strings.ToLower(info.GetAddress())
+		result[key] = ensEntry{address: info.GetAddress(), name: info.GetName()}
+		c.reverse.Set(key, result[key])
+	}
+	for key := range addresses {
+		if _, ok := result[key]; !ok {
+			c.unknownAddrs.Set(key, struct{}{})
+		}
+	}
+
+	return result, nil
+}
+
+type ensBatch struct {
+	values map[string]string
+	done   chan struct{}
+	result map[string]ensEntry
+	err    error
+}
+
+// ensBatcher collapses lookups started within the window into one fetch, values are keyed by the cache key.
+// Batches reaching maxSize values are fetched at once and the following values go to the next batch.
+type ensBatcher struct {
+	direction string
+	window    time.Duration
+	maxSize   int
+	fetch     func(ctx context.Context, values map[string]string) (map[string]ensEntry, error)
+
+	mu      sync.Mutex
+	pending *ensBatch
+}
+
+func newEnsBatcher(direction string, window time.Duration, maxSize int, fetch func(ctx context.Context, values map[string]string) (map[string]ensEntry, error)) *ensBatcher {
+	return &ensBatcher{
+		direction: direction,
+		window:    window,
+		maxSize:   maxSize,
+		fetch:     fetch,
+	}
+}
+
+func (b *ensBatcher) load(ctx context.Context, values map[string]string) (map[string]ensEntry, error) {
+	var batches []*ensBatch
+
+	b.mu.Lock()
+	for key, value := range values {
+		batch := b.pending
+		if batch == nil {
+			batch = &ensBatch{values: make(map[string]string), done: make(chan struct{})}
+			b.pending = batch
+			time.AfterFunc(b.window, func() { b.flush(batch) })
+		}
+		if len(batches) == 0 || batches[len(batches)-1] != batch {
+			batches = append(batches, batch)
+		}
+		if _, ok := batch.values[key]; !ok {
+			batch.values[key] = value
+		}
+		if b.maxSize > 0 && len(batch.values) >= b.maxSize {
+			b.pending = nil
+			go b.fetchBatch(batch)
+		}
+	}
+	b.mu.Unlock()
+
+	result := make(map[string]ensEntry, len(values))
+	for _, batch := range batches {
+		select {
+		case <-batch.done:
+			if batch.err != nil {
+				return nil, batch.err
+			}
+			for key, entry := range batch.result {
+				result[key] = entry
+			}
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	return result, nil
+}
+
+// flush fetches the batch when the window is over, full batches are already fetched.
+func (b *ensBatcher) flush(batch *ensBatch) {
+	b.mu.Lock()
+	if b.pending != batch {
+		b.mu.Unlock()
+
+		return
+	}
+	b.pending = nil
+	b.mu.Unlock()
+
+	b.fetchBatch(batch)
+}
+
+func (b *ensBatcher) fetchBatch(batch *ensBatch) {
+	ctx, cancel := context.WithTimeout(context.Background(), ensFetchTimeout)
+	defer cancel()
+
+	ensUpstreamCounter.WithLabelValues(b.direction).Inc()
+	ensBatchSize.WithLabelValues(b.direction).Observe(float64(len(batch.values)))
+
+	batch.result, batch.err = b.fetch(ctx, batch.values)
+	close(batch.done)
+}
@@ -0,0 +1,212 @@
+package helpers
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/goverland-labs/goverland-core-storage/protocol/storagepb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var testEnsCacheOptions = EnsCacheOptions{
+	ForwardTTL:  time.Minute,
+	ReverseTTL:  time.Minute,
+	NegativeTTL: time.Minute,
+	BatchWindow: time.Millisecond,
+}
+
+// countingEnsClient knows aci.eth only and counts the upstream calls.
+func countingEnsClient(forwardCalls, reverseCalls *atomic.Int32) *mockEnsClient {
+	return &mockEnsClient{
+		getAddressesByEnsNamesFunc: func(_ context.Context, in *storagepb.AddressesByEnsNamesRequest, _ ...grpc.CallOption) (*storagepb.AddressesByEnsNamesResponse, error) {
+			forwardCalls.Add(1)
+			resp := &storagepb.AddressesByEnsNamesResponse{}
+			for _, name := range in.GetNames() {
+				if name == "aci.eth" {
+					resp.EnsNames = append(resp.EnsNames, &storagepb.EnsName{Address: "0x329c54289Ff5D6B7b7daE13592C6B1EDA1543eD4", Name: "aci.eth"})
+				}
+			}
+			return resp, nil
+		},
+		getEnsByAddressesFunc: func(_ context.Context, in *storagepb.EnsByAddressesRequest, _ ...grpc.CallOption) (*storagepb.EnsByAddressesResponse, error) {
+			reverseCalls.Add(1)
+			resp := &storagepb.EnsByAddressesResponse{}
+			for _, address := range in.GetAddresses() {
+				if address == "0x329c54289Ff5D6B7b7daE13592C6B1EDA1543eD4" {
+					resp.EnsNames = append(resp.EnsNames, &storagepb.EnsName{Address: address, Name: "aci.eth"})
+				}
+			}
+			return resp, nil
+		},
+	}
+}
+
+func TestEnsCache_Resolve(t *testing.T) {
+	var forwardCalls, reverseCalls atomic.Int32
+	resolver := NewIdentifierResolver(NewEnsCache(countingEnsClient(&forwardCalls, &reverseCalls), testEnsCacheOptions))
+
+	for i := 0; i < 3; i++ {
+		result, err := resolver.Resolve(context.Background(), "ACI.eth")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if result.Address != "0x329c54289Ff5D6B7b7daE13592C6B1EDA1543eD4" {
+			t.Errorf("address = %q", result.Address)
+		}
+	}
+
+	if calls := forwardCalls.Load(); calls != 1 {
+		t.Errorf("upstream calls = %d, want 1", calls)
+	}
+}
+
+func TestEnsCache_NegativeEntries(t *testing.T) {
+	var forwardCalls, reverseCalls atomic.Int32
+	opts := testEnsCacheOptions
+	opts.NegativeTTL = 20 * time.Millisecond
+	resolver := NewIdentifierResolver(NewEnsCache(countingEnsClient(&forwardCalls, &reverseCalls), opts))
+
+	for i := 0; i < 2; i++ {
+		_, err := resolver.Resolve(context.Background(), "unknown.eth")
+		if status.Code(err) != codes.NotFound {
+			t.Fatalf("error = %v, want NotFound", err)
+		}
+	}
+	if calls := forwardCalls.Load(); calls != 1 {
+		t.Errorf("upstream calls = %d, want 1", calls)
+	}
+
+	time.Sleep(2 * opts.NegativeTTL)
+	_, _ = resolver.Resolve(context.Background(), "unknown.eth")
+	if calls := forwardCalls.Load(); calls != 2 {
+		t.Errorf("upstream calls after negative ttl = %d, want 2", calls)
+	}
+}
+
+func TestEnsCache_ErrorsAreNotCached(t *testing.T) {
+	var calls atomic.Int32
+	cache := NewEnsCache(&mockEnsClient{
+		getAddressesByEnsNamesFunc: func(_ context.Context, _ *storagepb.AddressesByEnsNamesRequest, _ ...grpc.CallOption) (*storagepb.AddressesByEnsNamesResponse, error) {
+			calls.Add(1)
+			return nil, status.Error(codes.Internal, "db down")
+		},
+	}, testEnsCacheOptions)
+	resolver := NewIdentifierResolver(cache)
+
+	for i := 0; i < 2; i++ {
+		if _, err := resolver.Resolve(context.Background(), "aci.eth"); status.Code(err) != codes.Internal {
+			t.Fatalf("error = %v, want Internal", err)
+		}
+	}
+	if calls.Load() != 2 {
+		t.Errorf("upstream calls = %d, want 2", calls.Load())
+	}
+}
+
+func TestEnsCache_BatchesConcurrentLookups(t *testing.T) {
+	var names []string
+	var mu sync.Mutex
+	var calls atomic.Int32
+	opts := testEnsCacheOptions
+	opts.BatchWindow = 50 * time.Millisecond
+	cache := NewEnsCache(&mockEnsClient{
+		getAddressesByEnsNamesFunc: func(_ context.Context, in *storagepb.AddressesByEnsNamesRequest, _ ...grpc.CallOption) (*storagepb.AddressesByEnsNamesResponse, error) {
+			calls.Add(1)
+			mu.Lock()
+			names = append(names, in.GetNames()...)
+			mu.Unlock()
+			return &storagepb.AddressesByEnsNamesResponse{}, nil
+		},
+	}, opts)
+
+	var wg sync.WaitGroup
+	for _, name := range []string{"a.eth", "b.eth", "c.eth", "a.eth"} {
+		wg.Add(1)
+		go func(name string) {
+			defer wg.Done()
+			_, _ = cache.GetAddressesByEnsNames(context.Background(), &storagepb.AddressesByEnsNamesRequest{Names: []string{name}})
+		}(name)
+	}
+	wg.Wait()
+
+	sort.Strings(names)
+	if calls.Load() != 1 || len(names) != 3 || names[0] != "a.eth" || names[2] != "c.eth" {
+		t.Errorf("upstream calls = %d with names %v, want 1 call with a.eth, b.eth, c.eth", calls.Load(), names)
+	}
+}
+
+func TestEnsCache_GetEnsByAddresses(t *testing.T) {
+	var forwardCalls, reverseCalls atomic.Int32
+	cache := NewEnsCache(countingEnsClient(&forwardCalls, &reverseCalls), testEnsCacheOptions)
+
+	req := &storagepb.EnsByAddressesRequest{Addresses: []string{
+		"0x329c54289Ff5D6B7b7daE13592C6B1EDA1543eD4",
+		"0x0000000000000000000000000000000000000001",
+	}}
+	for i := 0; i < 2; i++ {
+		resp, err := cache.GetEnsByAddresses(context.Background(), req)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(resp.GetEnsNames()) != 1 || resp.GetEnsNames()[0].GetName() != "aci.eth" {
+			t.Errorf("ens names = %v, want aci.eth only", resp.GetEnsNames())
+		}
+	}
+
+	// the lowercase address is served from the cache as well
+	_, _ = cache.GetEnsByAddresses(context.Background(), &storagepb.EnsByAddressesRequest{Addresses: []string{"0x329c54289ff5d6b7b7dae13592c6b1eda1543ed4"}})
+	if calls := reverseCalls.Load(); calls != 1 {
+		t.Errorf("upstream calls = %d, want 1", calls)
+	}
+}
+
+func TestEnsCache_CanceledLookup(t *testing.T) {
+	opts := testEnsCacheOptions
+	opts.BatchWindow = 200 * time.Millisecond
+	cache := NewEnsCache(&mockEnsClient{}, opts)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_, err := cache.GetAddressesByEnsNames(ctx, &storagepb.AddressesByEnsNamesRequest{Names: []string{"aci.eth"}})
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+}
+
+func TestEnsCache_Limits(t *testing.T) {
+	var (
+		mu    sync.Mutex
+		sizes []int
+	)
+	opts := testEnsCacheOptions
+	opts.MaxEntries = 3
+	opts.MaxBatchSize = 2
+	cache := NewEnsCache(&mockEnsClient{
+		getAddressesByEnsNamesFunc: func(_ context.Context, in *storagepb.AddressesByEnsNamesRequest, _ ...grpc.CallOption) (*storagepb.AddressesByEnsNamesResponse, error) {
+			mu.Lock()
+			sizes = append(sizes, len(in.GetNames()))
+			mu.Unlock()
+			return &storagepb.AddressesByEnsNamesResponse{}, nil
+		},
+	}, opts)
+
+	names := []string{"a.eth", "b.eth", "c.eth", "d.eth", "e.eth"}
+	if _, err := cache.GetAddressesByEnsNames(context.Background(), &storagepb.AddressesByEnsNamesRequest{Names: names}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	sort.Ints(sizes)
+	if len(sizes) != 3 || sizes[2] != 2 {
+		t.Errorf("upstream batch sizes = %v, want 3 batches of at most 2 names", sizes)
+	}
+	if n := cache.unknownNames.Len(); n != opts.MaxEntries {
+		t.Errorf("negative cache has %d entries, want %d", n, opts.MaxEntries)
+	}
+}
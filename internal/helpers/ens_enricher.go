@@ -0,0 +1,68 @@
+package helpers
+
+import (
+	"context"
+	"strings"
+
+	"github.com/goverland-labs/goverland-core-storage/protocol/storagepb"
+)
+
+// EnsNames collects the addresses of a response together with the fields their ENS names are written to.
+type EnsNames struct {
+	targets map[string][]*string
+}
+
+func NewEnsNames() *EnsNames {
+	return &EnsNames{targets: make(map[string][]*string)}
+}
+
+// Add registers the address to be resolved, the name is skipped when it is already filled.
+func (n *EnsNames) Add(address string, name *string) {
+	if address == "" || name == nil || *name != "" {
+		return
+	}
+
+	key := strings.ToLower(address)
+	n.targets[key] = append(n.targets[key], name)
+}
+
+func (n *EnsNames) Len() int {
+	return len(n.targets)
+}
+
+type EnsEnricher struct {
+	ensClient storagepb.EnsClient
+}
+
+func NewEnsEnricher(ensClient storagepb.EnsClient) *EnsEnricher {
+	return &EnsEnricher{ensClient: ensClient}
+}
+
+// Enrich fills the collected names with one reverse lookup, nil enricher leaves them untouched.
+func (e *EnsEnricher) Enrich(ctx context.Context, names *EnsNames) error {
+	if e == nil || names == nil || names.Len() == 0 {
+		return nil
+	}
+
+	list := make([]string, 0, len(names.targets))
+	for key := range names.targets {
+		list = append(list, key)
+	}
+
+	resp, err := e.ensClient.GetEnsByAddresses(ctx, &storagepb.EnsByAddressesRequest{Addresses: list})
+	if err != nil {
+		return err
+	}
+
+	for _, info := range resp.GetEnsNames() {
+		if info.GetName() == "" {
+			continue
+		}
+
+		for _, target := range names.targets[strings.ToLower(info.GetAddress())] {
+			*target = info.GetName()
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,88 @@
+package helpers
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/goverland-labs/goverland-core-storage/protocol/storagepb"
+	"google.golang.org/grpc"
+)
+
+func TestEnsEnricher_Enrich(t *testing.T) {
+	var requests [][]string
+	enricher := NewEnsEnricher(&mockEnsClient{
+		getEnsByAddressesFunc: func(_ context.Context, in *storagepb.EnsByAddressesRequest, _ ...grpc.CallOption) (*storagepb.EnsByAddressesResponse, error) {
+			requests = append(requests, in.GetAddresses())
+
+			return &storagepb.EnsByAddressesResponse{EnsNames: []*storagepb.EnsName{
+				{Address: "0x329c54289ff5d6b7b7dae13592c6b1eda1543ed4", Name: "aci.eth"},
+			}}, nil
+		},
+	})
+
+	author, voter, known, unknown := "", "", "vitalik.eth", ""
+	names := NewEnsNames()
+	names.Add("0x329c54289Ff5D6B7b7daE13592C6B1EDA1543eD4", &author)
+	names.Add("0x329c54289ff5d6b7b7dae13592c6b1eda1543ed4", &voter)
+	names.Add("0xd8dA6BF26964aF9D7eEd9e03E53415D37aA96045", &known)
+	names.Add("0x25F2226B597E8F9514B3F68F00f494cF4f286491", &unknown)
+
+	if err := enricher.Enrich(context.Background(), names); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(requests) != 1 || len(requests[0]) != 2 {
+		t.Fatalf("requests = %v, want one request with 2 addresses", requests)
+	}
+	if author != "aci.eth" || voter != "aci.eth" {
+		t.Errorf("author = %q, voter = %q, want aci.eth", author, voter)
+	}
+	if known != "vitalik.eth" {
+		t.Errorf("known = %q, filled name should be kept", known)
+	}
+	if unknown != "" {
+		t.Errorf("unknown = %q, want empty", unknown)
+	}
+}
+
+func TestEnsEnricher_Enrich_Skipped(t *testing.T) {
+	enricher := NewEnsEnricher(&mockEnsClient{
+		getEnsByAddressesFunc: func(_ context.Context, _ *storagepb.EnsByAddressesRequest, _ ...grpc.CallOption) (*storagepb.EnsByAddressesResponse, error) {
+			t.Fatal("upstream should not be called")
+
+			return nil, nil
+		},
+	})
+
+	if err := enricher.Enrich(context.Background(), NewEnsNames()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var disabled *EnsEnricher
+	name := ""
+	names := NewEnsNames()
+	names.Add("0x329c54289Ff5D6B7b7daE13592C6B1EDA1543eD4", &name)
+	if err := disabled.Enrich(context.Background(), names); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestEnsEnricher_Enrich_Error(t *testing.T) {
+	upstreamErr := errors.New("unavailable")
+	enricher := NewEnsEnricher(&mockEnsClient{
+		getEnsByAddressesFunc: func(_ context.Context, _ *storagepb.EnsByAddressesRequest, _ ...grpc.CallOption) (*storagepb.EnsByAddressesResponse, error) {
+			return nil, upstreamErr
+		},
+	})
+
+	name := ""
+	names := NewEnsNames()
+	names.Add("0x329c54289Ff5D6B7b7daE13592C6B1EDA1543eD4", &name)
+	if err := enricher.Enrich(context.Background(), names); !errors.Is(err, upstreamErr) {
+		t.Fatalf("err = %v, want %v", err, upstreamErr)
+	}
+	if name != "" {
+		t.Errorf("name = %q, want empty", name)
+	}
+}
@@ -2,14 +2,19 @@ package helpers
 
 import (
 	"context"
-	"encoding/hex"
+	"errors"
 	"strings"
 
 	"github.com/goverland-labs/goverland-core-storage/protocol/storagepb"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+
+	"github.com/goverland-labs/goverland-core-web-api/pkg/address"
 )
 
+// ErrInvalidChecksum is returned by IdentifierResolver.Resolve for a mixed case hex address with the wrong EIP-55 checksum.
+var ErrInvalidChecksum = errors.New("address should have the valid EIP-55 checksum")
+
 type ResolvedIdentifier struct {
 	Address string
 	ENSName string
@@ -30,8 +35,13 @@ func (r *IdentifierResolver) Resolve(ctx context.Context, identifier string) (*R
 	identifier = strings.TrimSpace(identifier)
 
 	if isHexAddress(identifier) {
+		addr, err := address.Parse(identifier)
+		if err != nil {
+			return nil, ErrInvalidChecksum
+		}
+
 		return &ResolvedIdentifier{
-			Address: identifier,
+			Address: addr.String(),
 			WasENS:  false,
 		}, nil
 	}
@@ -48,19 +58,12 @@ func (r *IdentifierResolver) Resolve(ctx context.Context, identifier string) (*R
 	}
 
 	return &ResolvedIdentifier{
-		Address: resp.GetEnsNames()[0].GetAddress(),
+		Address: address.Checksum(resp.GetEnsNames()[0].GetAddress()),
 		ENSName: identifier,
 		WasENS:  true,
 	}, nil
 }
 
 func isHexAddress(s string) bool {
-	if len(s) != 42 {
-		return false
-	}
-	if !strings.HasPrefix(s, "0x") && !strings.HasPrefix(s, "0X") {
-		return false
-	}
-	_, err := hex.DecodeString(s[2:])
-	return err == nil
+	return address.IsHex(s)
 }
@@ -2,6 +2,7 @@ package helpers
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"github.com/goverland-labs/goverland-core-storage/protocol/storagepb"
@@ -12,9 +13,13 @@ import (
 
 type mockEnsClient struct {
 	getAddressesByEnsNamesFunc func(ctx context.Context, in *storagepb.AddressesByEnsNamesRequest, opts ...grpc.CallOption) (*storagepb.AddressesByEnsNamesResponse, error)
+	getEnsByAddressesFunc      func(ctx context.Context, in *storagepb.EnsByAddressesRequest, opts ...grpc.CallOption) (*storagepb.EnsByAddressesResponse, error)
 }
 
-func (m *mockEnsClient) GetEnsByAddresses(_ context.Context, _ *storagepb.EnsByAddressesRequest, _ ...grpc.CallOption) (*storagepb.EnsByAddressesResponse, error) {
+func (m *mockEnsClient) GetEnsByAddresses(ctx context.Context, in *storagepb.EnsByAddressesRequest, opts ...grpc.CallOption) (*storagepb.EnsByAddressesResponse, error) {
+	if m.getEnsByAddressesFunc != nil {
+		return m.getEnsByAddressesFunc(ctx, in, opts...)
+	}
 	return nil, nil
 }
 
@@ -29,15 +34,15 @@ func TestIdentifierResolver_Resolve_HexAddress(t *testing.T) {
 	resolver := NewIdentifierResolver(&mockEnsClient{})
 
 	tests := []struct {
-		name    string
-		input   string
+		name     string
+		input    string
 		wantAddr string
-		wantENS bool
+		wantENS  bool
 	}{
-		{"lowercase hex", "0x329c54289ff5d6b7b7dae13592c6b1eda1543ed4", "0x329c54289ff5d6b7b7dae13592c6b1eda1543ed4", false},
+		{"lowercase hex", "0x329c54289ff5d6b7b7dae13592c6b1eda1543ed4", "0x329c54289Ff5D6B7b7daE13592C6B1EDA1543eD4", false},
 		{"checksum hex", "0x329c54289Ff5D6B7b7daE13592C6B1EDA1543eD4", "0x329c54289Ff5D6B7b7daE13592C6B1EDA1543eD4", false},
-		{"uppercase prefix", "0X329c54289ff5d6b7b7dae13592c6b1eda1543ed4", "0X329c54289ff5d6b7b7dae13592c6b1eda1543ed4", false},
-		{"with whitespace", "  0x329c54289ff5d6b7b7dae13592c6b1eda1543ed4  ", "0x329c54289ff5d6b7b7dae13592c6b1eda1543ed4", false},
+		{"uppercase prefix", "0X329c54289ff5d6b7b7dae13592c6b1eda1543ed4", "0x329c54289Ff5D6B7b7daE13592C6B1EDA1543eD4", false},
+		{"with whitespace", "  0x329c54289ff5d6b7b7dae13592c6b1eda1543ed4  ", "0x329c54289Ff5D6B7b7daE13592C6B1EDA1543eD4", false},
 	}
 
 	for _, tt := range tests {
@@ -59,6 +64,15 @@ func TestIdentifierResolver_Resolve_HexAddress(t *testing.T) {
 	}
 }
 
+func TestIdentifierResolver_Resolve_InvalidChecksum(t *testing.T) {
+	resolver := NewIdentifierResolver(&mockEnsClient{})
+
+	_, err := resolver.Resolve(context.Background(), "0x329C54289Ff5D6B7b7daE13592C6B1EDA1543eD4")
+	if !errors.Is(err, ErrInvalidChecksum) {
+		t.Errorf("error = %v, want %v", err, ErrInvalidChecksum)
+	}
+}
+
 func TestIdentifierResolver_Resolve_ENSName(t *testing.T) {
 	mock := &mockEnsClient{
 		getAddressesByEnsNamesFunc: func(_ context.Context, in *storagepb.AddressesByEnsNamesRequest, _ ...grpc.CallOption) (*storagepb.AddressesByEnsNamesResponse, error) {
@@ -199,7 +213,7 @@ func TestIsHexAddress(t *testing.T) {
 		{"0x", false},
 		{"0xZZZc54289ff5d6b7b7dae13592c6b1eda1543ed4", false},
 		{"329c54289ff5d6b7b7dae13592c6b1eda1543ed4", false},
-		{"0x329c54289ff5d6b7b7dae13592c6b1eda1543ed", false},  // 41 chars
+		{"0x329c54289ff5d6b7b7dae13592c6b1eda1543ed", false},   // 41 chars
 		{"0x329c54289ff5d6b7b7dae13592c6b1eda1543ed44", false}, // 43 chars
 		{"", false},
 	}
@@ -0,0 +1,130 @@
+package helpers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/netip"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const webhookLookupTimeout = 2 * time.Second
+
+var (
+	ErrWebhookURLTooLong      = errors.New("url is too long")
+	ErrWebhookURLFormat       = errors.New("should be an absolute http or https url")
+	ErrWebhookURLScheme       = errors.New("should use https")
+	ErrWebhookURLCredentials  = errors.New("should not contain credentials")
+	ErrWebhookURLPort         = errors.New("port is not allowed")
+	ErrWebhookURLUnresolvable = errors.New("host can not be resolved")
+	ErrWebhookURLAddress      = errors.New("should not point to a private, loopback or link-local address")
+)
+
+// sharedAddressSpace is the carrier-grade NAT range (RFC 6598) which is not covered by netip.Addr.IsPrivate.
+var sharedAddressSpace = netip.MustParsePrefix("100.64.0.0/10")
+
+// HostResolver is satisfied by net.Resolver.
+type HostResolver interface {
+	LookupIPAddr(ctx context.Context, host string) ([]net.IPAddr, error)
+}
+
+type WebhookURLPolicy struct {
+	HTTPSOnly    bool
+	AllowedPorts []int
+	MaxLength    int
+}
+
+// WebhookURLValidator rejects webhook URLs which make core-feed send requests into the internal network.
+// The host is resolved once on validation, so it does not protect from DNS records changed afterwards.
+type WebhookURLValidator struct {
+	policy   WebhookURLPolicy
+	resolver HostResolver
+}
+
+func NewWebhookURLValidator(policy WebhookURLPolicy, resolver HostResolver) *WebhookURLValidator {
+	return &WebhookURLValidator{
+		policy:   policy,
+		resolver: resolver,
+	}
+}
+
+func (v *WebhookURLValidator) Validate(ctx context.Context, raw string) error {
+	if v.policy.MaxLength > 0 && len(raw) > v.policy.MaxLength {
+		return fmt.Errorf("%w: should be less or equal than %d characters", ErrWebhookURLTooLong, v.policy.MaxLength)
+	}
+
+	u, err := url.Parse(raw)
+	if err != nil || u.Hostname() == "" || u.Opaque != "" {
+		return ErrWebhookURLFormat
+	}
+
+	var defaultPort int
+	switch u.Scheme {
+	case "https":
+		defaultPort = 443
+	case "http":
+		if v.policy.HTTPSOnly {
+			return ErrWebhookURLScheme
+		}
+		defaultPort = 80
+	default:
+		return ErrWebhookURLFormat
+	}
+
+	if u.User != nil {
+		return ErrWebhookURLCredentials
+	}
+
+	port := defaultPort
+	if u.Port() != "" {
+		port, err = strconv.Atoi(u.Port())
+		if err != nil {
+			return ErrWebhookURLFormat
+		}
+	}
+	if len(v.policy.AllowedPorts) > 0 && !slices.Contains(v.policy.AllowedPorts, port) {
+		return fmt.Errorf("%w: %d", ErrWebhookURLPort, port)
+	}
+
+	return v.validateHost(ctx, u.Hostname())
+}
+
+func (v *WebhookURLValidator) validateHost(ctx context.Context, host string) error {
+	if addr, err := netip.ParseAddr(host); err == nil {
+		return validateWebhookAddr(addr)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, webhookLookupTimeout)
+	defer cancel()
+
+	addrs, err := v.resolver.LookupIPAddr(ctx, strings.TrimSuffix(host, "."))
+	if err != nil || len(addrs) == 0 {
+		return ErrWebhookURLUnresolvable
+	}
+
+	for _, ip := range addrs {
+		addr, ok := netip.AddrFromSlice(ip.IP)
+		if !ok {
+			return ErrWebhookURLUnresolvable
+		}
+		if err := validateWebhookAddr(addr); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validateWebhookAddr(addr netip.Addr) error {
+	addr = addr.Unmap()
+	if !addr.IsGlobalUnicast() || addr.IsPrivate() || sharedAddressSpace.Contains(addr) {
+		return ErrWebhookURLAddress
+	}
+
+	return nil
+}
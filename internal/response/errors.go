@@ -46,6 +46,24 @@ func NewNotFoundError() *NotFoundError {
 	return err
 }
 
+type UnauthorizedError struct {
+	BaseError
+}
+
+func (e *UnauthorizedError) PublicMessage() string {
+	return "unauthorized"
+}
+
+func (e *UnauthorizedError) GetHTTPStatus() int {
+	return http.StatusUnauthorized
+}
+
+func NewUnauthorizedError() *UnauthorizedError {
+	err := &UnauthorizedError{}
+
+	return err
+}
+
 type PermissionDeniedError struct {
 	BaseError
 }
@@ -177,3 +195,23 @@ func (e *RateLimitedError) PublicMessage() string {
 func (e *RateLimitedError) GetHTTPStatus() int {
 	return http.StatusTooManyRequests
 }
+
+type ConflictError struct {
+	BaseError
+
+	message string
+}
+
+func NewConflictError(message string) *ConflictError {
+	return &ConflictError{
+		message: message,
+	}
+}
+
+func (e *ConflictError) PublicMessage() string {
+	return e.message
+}
+
+func (e *ConflictError) GetHTTPStatus() int {
+	return http.StatusConflict
+}
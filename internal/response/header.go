@@ -3,6 +3,9 @@ package response
 import (
 	"fmt"
 	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
 )
 
 const (
@@ -10,14 +13,96 @@ const (
 	HeaderTotalVp       = "X-Total-Vp"
 	HeaderCurrentOffset = "X-Offset"
 	HeaderLimit         = "X-Limit"
+	HeaderNextCursor    = "X-Next-Cursor"
+	HeaderLink          = "Link"
+	// HeaderTotalTruncated reports that the total count is the lower bound, only a part of the list was checked
+	HeaderTotalTruncated = "X-Total-Truncated"
+
+	HeaderResolvedAddress = "X-Resolved-Address"
+	HeaderResolvedEnsName = "X-Resolved-Ens-Name"
 )
 
-func AddPaginationHeaders(w http.ResponseWriter, offset, limit, totalCnt uint64) {
+// AddPaginationHeaders sets the pagination metadata of the list and RFC 8288 links to the first, previous,
+// next and last pages. Links keep the query of the request and replace offset and limit.
+func AddPaginationHeaders(w http.ResponseWriter, r *http.Request, offset, limit, totalCnt uint64) {
 	w.Header().Set(HeaderTotalCount, fmt.Sprintf("%d", totalCnt))
 	w.Header().Set(HeaderCurrentOffset, fmt.Sprintf("%d", offset))
 	w.Header().Set(HeaderLimit, fmt.Sprintf("%d", limit))
+
+	if links := paginationLinks(r.URL, offset, limit, totalCnt); links != "" {
+		w.Header().Set(HeaderLink, links)
+	}
+}
+
+func paginationLinks(u *url.URL, offset, limit, totalCnt uint64) string {
+	if limit == 0 {
+		return ""
+	}
+
+	var last uint64
+	if totalCnt > 0 {
+		last = (totalCnt - 1) / limit * limit
+	}
+
+	links := make([]string, 0, 4)
+	links = append(links, pageLink(u, 0, limit, "first"))
+	if offset > 0 {
+		prev := uint64(0)
+		if offset > limit {
+			prev = offset - limit
+		}
+		links = append(links, pageLink(u, prev, limit, "prev"))
+	}
+	if offset+limit < totalCnt {
+		links = append(links, pageLink(u, offset+limit, limit, "next"))
+	}
+	links = append(links, pageLink(u, last, limit, "last"))
+
+	return strings.Join(links, ", ")
+}
+
+func pageLink(u *url.URL, offset, limit uint64, rel string) string {
+	query := u.Query()
+	// the cursor points to the specific page, offset links should not depend on it
+	query.Del("cursor")
+	query.Set("offset", strconv.FormatUint(offset, 10))
+	query.Set("limit", strconv.FormatUint(limit, 10))
+
+	link := url.URL{Path: u.Path, RawQuery: query.Encode()}
+
+	return fmt.Sprintf(`<%s>; rel="%s"`, link.String(), rel)
+}
+
+// AddTotalCountHeader sets the number of items of the list returned at once without pagination.
+func AddTotalCountHeader(w http.ResponseWriter, totalCnt uint64) {
+	w.Header().Set(HeaderTotalCount, strconv.FormatUint(totalCnt, 10))
 }
 
 func AddTotalVpHeader(w http.ResponseWriter, vp float32) {
 	w.Header().Set(HeaderTotalVp, fmt.Sprintf("%f", vp))
 }
+
+// AddNextCursorHeader sets the cursor of the next page, the header is omitted on the last page.
+func AddNextCursorHeader(w http.ResponseWriter, cursor string) {
+	if cursor == "" {
+		return
+	}
+
+	w.Header().Set(HeaderNextCursor, cursor)
+}
+
+// AddResolvedIdentifierHeaders reports the address the ENS name of the request was resolved to,
+// the headers are omitted when the address was given as is.
+func AddResolvedIdentifierHeaders(w http.ResponseWriter, address, ensName string) {
+	if ensName == "" {
+		return
+	}
+
+	w.Header().Set(HeaderResolvedAddress, address)
+	w.Header().Set(HeaderResolvedEnsName, ensName)
+}
+
+// AddTotalTruncatedHeader marks the total count of the list as the lower bound.
+func AddTotalTruncatedHeader(w http.ResponseWriter) {
+	w.Header().Set(HeaderTotalTruncated, "true")
+}
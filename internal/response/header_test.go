@@ -0,0 +1,54 @@
+package response
+
+import (
+	"net/http/httptest"
+	"testing"
+)
+
+func TestAddPaginationHeaders(t *testing.T) {
+	for name, tc := range map[string]struct {
+		target               string
+		offset, limit, total uint64
+		link                 string
+	}{
+		"middle page": {
+			target: "/v1/proposals?dao=aave.eth&offset=20&limit=10",
+			offset: 20, limit: 10, total: 45,
+			link: `</v1/proposals?dao=aave.eth&limit=10&offset=0>; rel="first", ` +
+				`</v1/proposals?dao=aave.eth&limit=10&offset=10>; rel="prev", ` +
+				`</v1/proposals?dao=aave.eth&limit=10&offset=30>; rel="next", ` +
+				`</v1/proposals?dao=aave.eth&limit=10&offset=40>; rel="last"`,
+		},
+		"first page without cursor": {
+			target: "/v1/feed?cursor=abc",
+			offset: 0, limit: 20, total: 40,
+			link: `</v1/feed?limit=20&offset=0>; rel="first", ` +
+				`</v1/feed?limit=20&offset=20>; rel="next", ` +
+				`</v1/feed?limit=20&offset=20>; rel="last"`,
+		},
+		"offset is not aligned": {
+			target: "/v1/feed",
+			offset: 5, limit: 20, total: 10,
+			link: `</v1/feed?limit=20&offset=0>; rel="first", ` +
+				`</v1/feed?limit=20&offset=0>; rel="prev", ` +
+				`</v1/feed?limit=20&offset=0>; rel="last"`,
+		},
+		"empty list": {
+			target: "/v1/feed",
+			limit:  20,
+			link:   `</v1/feed?limit=20&offset=0>; rel="first", </v1/feed?limit=20&offset=0>; rel="last"`,
+		},
+		"no limit": {
+			target: "/v1/feed",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			AddPaginationHeaders(w, httptest.NewRequest("GET", tc.target, nil), tc.offset, tc.limit, tc.total)
+
+			if got := w.Header().Get(HeaderLink); got != tc.link {
+				t.Errorf("unexpected link header\n got: %s\nwant: %s", got, tc.link)
+			}
+		})
+	}
+}
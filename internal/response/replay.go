@@ -0,0 +1,16 @@
+package response
+
+import "net/http"
+
+// replayBodySetter is implemented by the writers storing the responses of the idempotent requests.
+type replayBodySetter interface {
+	SetReplayBody(body []byte)
+}
+
+// SetReplayBody sets the body replayed to the retries of the idempotent request in place of the response which is
+// not stored, e.g. the one with credentials. It is ignored for the requests which are not deduplicated.
+func SetReplayBody(w http.ResponseWriter, body []byte) {
+	if s, ok := w.(replayBodySetter); ok {
+		s.SetReplayBody(body)
+	}
+}
@@ -0,0 +1,207 @@
+// Package auth issues and verifies the signed tokens of webhook subscribers.
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+
+	"github.com/goverland-labs/goverland-core-web-api/internal/rest/helpers"
+	"github.com/goverland-labs/goverland-core-web-api/pkg/ttlcache"
+)
+
+const (
+	issuer = "goverland-core-web-api"
+
+	// audiences tell apart the access tokens of the subscriber routes and the refresh tokens exchanged for them
+	accessAudience  = "subscriber"
+	refreshAudience = "subscriber-refresh"
+)
+
+var (
+	ErrMissingToken = errors.New("token is missing")
+	ErrInvalidToken = errors.New("token is invalid")
+	ErrRevokedToken = errors.New("token is revoked")
+)
+
+// Claims of the subscriber token, the subject is the subscriber ID.
+type Claims struct {
+	jwt.RegisteredClaims
+	// RefreshID is the ID of the refresh token issued with the access token, it is revoked with the access token
+	RefreshID string `json:"rid,omitempty"`
+}
+
+// Token is the short-lived access token and the refresh token to exchange for the next pair.
+type Token struct {
+	Value            string
+	ExpiresAt        time.Time
+	Refresh          string
+	RefreshExpiresAt time.Time
+}
+
+// Tokens issues HS256 JWTs for subscribers. Access tokens are short-lived and exchanged for new ones by the refresh
+// tokens. Revoked tokens are kept in memory until they expire, so revocation is local to the instance, other
+// instances accept a revoked access token until it expires and a revoked refresh token until it is used there.
+type Tokens struct {
+	secret      []byte
+	ttl         time.Duration
+	refreshTTL  time.Duration
+	acceptRawID bool
+	revoked     *ttlcache.Cache[string, struct{}]
+	now         func() time.Time
+}
+
+// NewTokens creates access tokens valid for ttl and refresh tokens valid for refreshTTL. When acceptRawID is set,
+// a raw subscriber ID is accepted in place of the access and the refresh token, it allows the clients to exchange
+// their IDs for the tokens.
+func NewTokens(secret []byte, ttl, refreshTTL time.Duration, acceptRawID bool) *Tokens {
+	return &Tokens{
+		secret:      secret,
+		ttl:         ttl,
+		refreshTTL:  refreshTTL,
+		acceptRawID: acceptRawID,
+		revoked:     ttlcache.New[string, struct{}](max(ttl, refreshTTL)),
+		now:         time.Now,
+	}
+}
+
+// Issue signs a new access token and the refresh token for it.
+func (t *Tokens) Issue(subscriberID string) (Token, error) {
+	now := t.now()
+	refreshID := uuid.NewString()
+
+	refresh, refreshExpiresAt, err := t.sign(Claims{
+		RegisteredClaims: t.registeredClaims(subscriberID, refreshID, refreshAudience, now, t.refreshTTL),
+	})
+	if err != nil {
+		return Token{}, err
+	}
+
+	value, expiresAt, err := t.sign(Claims{
+		RegisteredClaims: t.registeredClaims(subscriberID, uuid.NewString(), accessAudience, now, t.ttl),
+		RefreshID:        refreshID,
+	})
+	if err != nil {
+		return Token{}, err
+	}
+
+	return Token{
+		Value:            value,
+		ExpiresAt:        expiresAt,
+		Refresh:          refresh,
+		RefreshExpiresAt: refreshExpiresAt,
+	}, nil
+}
+
+func (t *Tokens) registeredClaims(subscriberID, id, audience string, now time.Time, ttl time.Duration) jwt.RegisteredClaims {
+	return jwt.RegisteredClaims{
+		Issuer:    issuer,
+		Subject:   subscriberID,
+		Audience:  jwt.ClaimStrings{audience},
+		ID:        id,
+		IssuedAt:  jwt.NewNumericDate(now),
+		ExpiresAt: jwt.NewNumericDate(now.Add(ttl).Truncate(time.Second)),
+	}
+}
+
+func (t *Tokens) sign(claims Claims) (string, time.Time, error) {
+	value, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(t.secret)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("sign token: %w", err)
+	}
+
+	return value, claims.ExpiresAt.Time, nil
+}
+
+// Verify returns the claims of the access token.
+func (t *Tokens) Verify(value string) (*Claims, error) {
+	return t.verify(value, accessAudience)
+}
+
+// VerifyRefresh returns the claims of the refresh token.
+func (t *Tokens) VerifyRefresh(value string) (*Claims, error) {
+	return t.verify(value, refreshAudience)
+}
+
+func (t *Tokens) verify(value, audience string) (*Claims, error) {
+	claims := &Claims{}
+	_, err := jwt.ParseWithClaims(value, claims, func(*jwt.Token) (interface{}, error) {
+		return t.secret, nil
+	},
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+		jwt.WithIssuer(issuer),
+		jwt.WithAudience(audience),
+		jwt.WithExpirationRequired(),
+		jwt.WithTimeFunc(t.now),
+	)
+	if err != nil || claims.Subject == "" || claims.ID == "" {
+		return nil, ErrInvalidToken
+	}
+
+	if _, ok := t.revoked.Get(claims.ID); ok {
+		return nil, ErrRevokedToken
+	}
+
+	return claims, nil
+}
+
+// Revoke revokes the token and the refresh token issued with it.
+func (t *Tokens) Revoke(claims *Claims) {
+	t.revoked.Set(claims.ID, struct{}{})
+	if claims.RefreshID != "" {
+		t.revoked.Set(claims.RefreshID, struct{}{})
+	}
+}
+
+// Authenticate returns the subscriber of the access token of the request, claims are nil for the accepted raw
+// subscriber ID.
+func (t *Tokens) Authenticate(header http.Header) (Subscriber, error) {
+	return t.authenticate(header, t.Verify)
+}
+
+// AuthenticateRefresh returns the subscriber of the refresh token of the request, claims are nil for the accepted
+// raw subscriber ID.
+func (t *Tokens) AuthenticateRefresh(header http.Header) (Subscriber, error) {
+	return t.authenticate(header, t.VerifyRefresh)
+}
+
+func (t *Tokens) authenticate(header http.Header, verify func(string) (*Claims, error)) (Subscriber, error) {
+	value := helpers.ExtractTokenFromHeaders(header)
+	if value == "" {
+		return Subscriber{}, ErrMissingToken
+	}
+
+	if t.acceptRawID && strings.Count(value, ".") != 2 {
+		return Subscriber{ID: value}, nil
+	}
+
+	claims, err := verify(value)
+	if err != nil {
+		return Subscriber{}, err
+	}
+
+	return Subscriber{ID: claims.Subject, Claims: claims}, nil
+}
+
+type Subscriber struct {
+	ID     string
+	Claims *Claims
+}
+
+type subscriberKey struct{}
+
+func WithSubscriber(ctx context.Context, subscriber Subscriber) context.Context {
+	return context.WithValue(ctx, subscriberKey{}, subscriber)
+}
+
+func SubscriberFromContext(ctx context.Context) (Subscriber, bool) {
+	subscriber, ok := ctx.Value(subscriberKey{}).(Subscriber)
+
+	return subscriber, ok
+}
@@ -0,0 +1,155 @@
+package auth
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+var testSecret = []byte("0123456789abcdef0123456789abcdef")
+
+func TestTokens_Verify(t *testing.T) {
+	now := time.Date(2026, 2, 1, 12, 0, 0, 0, time.UTC)
+	tokens := NewTokens(testSecret, time.Hour, 24*time.Hour, false)
+	tokens.now = func() time.Time { return now }
+
+	token, err := tokens.Issue("subscriber-id")
+	if err != nil {
+		t.Fatalf("Issue() error = %v", err)
+	}
+	if want := now.Add(time.Hour); !token.ExpiresAt.Equal(want) {
+		t.Errorf("ExpiresAt = %v, want %v", token.ExpiresAt, want)
+	}
+	if want := now.Add(24 * time.Hour); !token.RefreshExpiresAt.Equal(want) {
+		t.Errorf("RefreshExpiresAt = %v, want %v", token.RefreshExpiresAt, want)
+	}
+
+	other := NewTokens([]byte("fedcba9876543210fedcba9876543210"), time.Hour, 24*time.Hour, false)
+	otherToken, _ := other.Issue("subscriber-id")
+
+	tests := []struct {
+		name    string
+		token   string
+		at      time.Time
+		wantErr error
+	}{
+		{"valid", token.Value, now.Add(time.Minute), nil},
+		{"expired", token.Value, now.Add(2 * time.Hour), ErrInvalidToken},
+		{"signed by another secret", otherToken.Value, now, ErrInvalidToken},
+		{"tampered", token.Value[:len(token.Value)-2] + "xx", now, ErrInvalidToken},
+		{"raw id", "subscriber-id", now, ErrInvalidToken},
+		{"refresh token", token.Refresh, now, ErrInvalidToken},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tokens.now = func() time.Time { return tt.at }
+
+			claims, err := tokens.Verify(tt.token)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Verify() error = %v, want %v", err, tt.wantErr)
+			}
+			if err == nil && claims.Subject != "subscriber-id" {
+				t.Errorf("Subject = %q, want subscriber-id", claims.Subject)
+			}
+		})
+	}
+}
+
+func TestTokens_Revoke(t *testing.T) {
+	tokens := NewTokens(testSecret, time.Hour, 24*time.Hour, false)
+
+	first, _ := tokens.Issue("subscriber-id")
+	second, _ := tokens.Issue("subscriber-id")
+
+	claims, err := tokens.Verify(first.Value)
+	if err != nil {
+		t.Fatalf("Verify() error = %v", err)
+	}
+	tokens.Revoke(claims)
+
+	if _, err := tokens.Verify(first.Value); !errors.Is(err, ErrRevokedToken) {
+		t.Errorf("Verify(revoked) error = %v, want %v", err, ErrRevokedToken)
+	}
+	if _, err := tokens.VerifyRefresh(first.Refresh); !errors.Is(err, ErrRevokedToken) {
+		t.Errorf("VerifyRefresh(refresh token of revoked) error = %v, want %v", err, ErrRevokedToken)
+	}
+	if _, err := tokens.Verify(second.Value); err != nil {
+		t.Errorf("Verify(other token) error = %v", err)
+	}
+	if _, err := tokens.VerifyRefresh(second.Refresh); err != nil {
+		t.Errorf("VerifyRefresh(other refresh token) error = %v", err)
+	}
+}
+
+func TestTokens_VerifyRefresh(t *testing.T) {
+	now := time.Date(2026, 2, 1, 12, 0, 0, 0, time.UTC)
+	tokens := NewTokens(testSecret, time.Hour, 24*time.Hour, false)
+	tokens.now = func() time.Time { return now }
+
+	token, _ := tokens.Issue("subscriber-id")
+
+	tests := []struct {
+		name    string
+		token   string
+		at      time.Time
+		wantErr error
+	}{
+		{"valid after the access token expired", token.Refresh, now.Add(2 * time.Hour), nil},
+		{"expired", token.Refresh, now.Add(25 * time.Hour), ErrInvalidToken},
+		{"access token", token.Value, now, ErrInvalidToken},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tokens.now = func() time.Time { return tt.at }
+
+			claims, err := tokens.VerifyRefresh(tt.token)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("VerifyRefresh() error = %v, want %v", err, tt.wantErr)
+			}
+			if err == nil && claims.Subject != "subscriber-id" {
+				t.Errorf("Subject = %q, want subscriber-id", claims.Subject)
+			}
+		})
+	}
+}
+
+func TestTokens_Authenticate(t *testing.T) {
+	strict := NewTokens(testSecret, time.Hour, 24*time.Hour, false)
+	lenient := NewTokens(testSecret, time.Hour, 24*time.Hour, true)
+	token, _ := strict.Issue("subscriber-id")
+
+	tests := []struct {
+		name          string
+		tokens        *Tokens
+		authorization string
+		wantID        string
+		wantErr       error
+	}{
+		{"bearer token", strict, "Bearer " + token.Value, "subscriber-id", nil},
+		{"token without scheme", strict, token.Value, "subscriber-id", nil},
+		{"missing", strict, "", "", ErrMissingToken},
+		{"raw id rejected", strict, "raw-subscriber-id", "", ErrInvalidToken},
+		{"raw id accepted", lenient, "raw-subscriber-id", "raw-subscriber-id", nil},
+		{"token with raw ids accepted", lenient, "Bearer " + token.Value, "subscriber-id", nil},
+		{"invalid token with raw ids accepted", lenient, token.Value + "x", "", ErrInvalidToken},
+		{"refresh token", strict, "Bearer " + token.Refresh, "", ErrInvalidToken},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			header := http.Header{}
+			header.Set("Authorization", tt.authorization)
+
+			subscriber, err := tt.tokens.Authenticate(header)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Authenticate() error = %v, want %v", err, tt.wantErr)
+			}
+			if subscriber.ID != tt.wantID {
+				t.Errorf("ID = %q, want %q", subscriber.ID, tt.wantID)
+			}
+		})
+	}
+}
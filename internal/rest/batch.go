@@ -0,0 +1,185 @@
+package rest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/rs/zerolog/log"
+
+	"github.com/goverland-labs/goverland-core-web-api/internal/response"
+	forms "github.com/goverland-labs/goverland-core-web-api/internal/rest/form/batch"
+	"github.com/goverland-labs/goverland-core-web-api/internal/rest/models/batch"
+	"github.com/goverland-labs/goverland-core-web-api/internal/rest/openapi"
+)
+
+const BatchPath = "/v1/batch"
+
+// forwardedHeaders are copied from the batch request to every sub-request.
+var forwardedHeaders = []string{"Authorization", "Accept-Language", "X-Forwarded-For", "X-Real-Ip"}
+
+// batchHandler runs sub-requests concurrently through the router, so they pass the same middlewares
+// as the standalone requests. All sub-requests share the deadline of the batch.
+type batchHandler struct {
+	router *mux.Router
+	// unbatched contains the routes, e.g. "GET /v1/feed.atom", which responses can not be embedded into the batch
+	unbatched   map[string]bool
+	maxRequests int
+	timeout     time.Duration
+}
+
+func newBatchHandler(router *mux.Router, unbatched map[string]bool, maxRequests int, timeout time.Duration) *batchHandler {
+	return &batchHandler{
+		router:      router,
+		unbatched:   unbatched,
+		maxRequests: maxRequests,
+		timeout:     timeout,
+	}
+}
+
+func (h *batchHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	form, verr := forms.NewBatchForm(h.maxRequests, BatchPath, h.batchable).ParseAndValidate(r)
+	if verr != nil {
+		response.HandleError(verr, w)
+
+		return
+	}
+
+	params := form.(*forms.Batch)
+
+	ctx, cancel := context.WithTimeout(r.Context(), h.timeout)
+	defer cancel()
+
+	result := batch.Response{Responses: make([]batch.Item, len(params.Requests))}
+
+	var wg sync.WaitGroup
+	for i, sub := range params.Requests {
+		wg.Add(1)
+		go func(i int, sub forms.SubRequest) {
+			defer wg.Done()
+
+			result.Responses[i] = h.serve(ctx, r, sub)
+		}(i, sub)
+	}
+	wg.Wait()
+
+	_ = json.NewEncoder(w).Encode(result)
+}
+
+// batchable reports whether the sub-request targets the route with the JSON response. Unknown routes are batchable,
+// the router responds to them with the error.
+func (h *batchHandler) batchable(method, path string) bool {
+	req, err := http.NewRequest(method, path, nil)
+	if err != nil {
+		return true
+	}
+
+	var match mux.RouteMatch
+	if !h.router.Match(req, &match) || match.Route == nil {
+		return true
+	}
+
+	tpl, err := match.Route.GetPathTemplate()
+
+	return err != nil || !h.unbatched[method+" "+tpl]
+}
+
+func (h *batchHandler) serve(ctx context.Context, parent *http.Request, sub forms.SubRequest) batch.Item {
+	req, err := http.NewRequestWithContext(ctx, sub.Method, sub.Path, bytes.NewReader(sub.Body))
+	if err != nil {
+		log.Warn().Err(err).Str("path", sub.Path).Msg("create batch sub-request")
+
+		return batch.Item{ID: sub.ID, Status: http.StatusBadRequest, Body: errorBody("invalid request")}
+	}
+
+	req.RemoteAddr = parent.RemoteAddr
+	if len(sub.Body) > 0 {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	for _, name := range forwardedHeaders {
+		if value := parent.Header.Get(name); value != "" {
+			req.Header.Set(name, value)
+		}
+	}
+
+	rec := newRecorder()
+	h.router.ServeHTTP(rec, req)
+
+	item := batch.Item{
+		ID:      sub.ID,
+		Status:  rec.status,
+		Headers: make(map[string]string, len(rec.header)),
+	}
+	for name := range rec.header {
+		if name == "Content-Type" {
+			continue
+		}
+		item.Headers[name] = rec.header.Get(name)
+	}
+
+	body := bytes.TrimSpace(rec.body.Bytes())
+	switch {
+	case len(body) == 0:
+	case json.Valid(body):
+		item.Body = body
+	default:
+		item.Body, _ = json.Marshal(string(body))
+	}
+
+	return item
+}
+
+func errorBody(message string) json.RawMessage {
+	body, _ := json.Marshal(map[string]string{"message": message})
+
+	return body
+}
+
+func batchRoute() openapi.Route {
+	return openapi.Route{
+		Method:   http.MethodPost,
+		Path:     BatchPath,
+		Summary:  "Run several requests in one round trip",
+		Tags:     []string{"batch"},
+		Body:     forms.Request{},
+		Response: batch.Response{},
+	}
+}
+
+// recorder collects the response of the sub-request.
+type recorder struct {
+	header      http.Header
+	status      int
+	wroteHeader bool
+	body        bytes.Buffer
+}
+
+func newRecorder() *recorder {
+	return &recorder{
+		header: make(http.Header),
+		status: http.StatusOK,
+	}
+}
+
+func (r *recorder) Header() http.Header {
+	return r.header
+}
+
+func (r *recorder) WriteHeader(status int) {
+	if r.wroteHeader {
+		return
+	}
+
+	r.status = status
+	r.wroteHeader = true
+}
+
+func (r *recorder) Write(data []byte) (int, error) {
+	r.WriteHeader(http.StatusOK)
+
+	return r.body.Write(data)
+}
@@ -0,0 +1,91 @@
+// Package fieldset implements sparse fieldsets: responses are trimmed to the top level JSON fields requested
+// in the `fields` query param.
+package fieldset
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+)
+
+// Names returns JSON names of the top level fields of the model, the model may be a struct or a slice of structs.
+func Names(model any) []string {
+	t := reflect.TypeOf(model)
+	for t.Kind() == reflect.Pointer || t.Kind() == reflect.Slice {
+		t = t.Elem()
+	}
+
+	var names []string
+	collectNames(t, &names)
+
+	return names
+}
+
+func collectNames(t reflect.Type, names *[]string) {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := f.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+
+		name, _, _ := strings.Cut(tag, ",")
+		if f.Anonymous && name == "" && f.Type.Kind() == reflect.Struct {
+			collectNames(f.Type, names)
+
+			continue
+		}
+
+		if !f.IsExported() {
+			continue
+		}
+
+		if name == "" {
+			name = f.Name
+		}
+		*names = append(*names, name)
+	}
+}
+
+// Select keeps only the given top level fields of v, items of slices are trimmed one by one.
+// v is returned as is when fields are empty.
+func Select(v any, fields []string) any {
+	if len(fields) == 0 {
+		return v
+	}
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		return v
+	}
+
+	keep := make(map[string]struct{}, len(fields))
+	for _, f := range fields {
+		keep[f] = struct{}{}
+	}
+
+	var list []map[string]json.RawMessage
+	if err := json.Unmarshal(data, &list); err == nil {
+		for _, item := range list {
+			trim(item, keep)
+		}
+
+		return list
+	}
+
+	var item map[string]json.RawMessage
+	if err := json.Unmarshal(data, &item); err != nil {
+		return v
+	}
+	trim(item, keep)
+
+	return item
+}
+
+func trim(item map[string]json.RawMessage, keep map[string]struct{}) {
+	for name := range item {
+		if _, ok := keep[name]; !ok {
+			delete(item, name)
+		}
+	}
+}
@@ -0,0 +1,116 @@
+package batch
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/goverland-labs/goverland-core-web-api/internal/response"
+	"github.com/goverland-labs/goverland-core-web-api/internal/response/errs"
+	"github.com/goverland-labs/goverland-core-web-api/internal/rest/form"
+)
+
+var supportedMethods = []string{http.MethodGet, http.MethodPost, http.MethodDelete}
+
+type SubRequest struct {
+	// ID is returned with the response of the sub-request
+	ID     string `json:"id"`
+	Method string `json:"method"`
+	// Path is the route with the query, e.g. /v1/daos/{id}?fields=id,name
+	Path string `json:"path"`
+	// Body is the JSON body of the sub-request
+	Body json.RawMessage `json:"body,omitempty"`
+}
+
+type Request struct {
+	Requests []SubRequest `json:"requests" validate:"required"`
+}
+
+type Batch struct {
+	Requests []SubRequest
+
+	maxRequests int
+	batchPath   string
+	batchable   func(method, path string) bool
+}
+
+// NewBatchForm creates the form accepting up to maxRequests sub-requests, batchPath can not be nested and
+// the routes rejected by batchable, e.g. streamed exports, can not be batched.
+func NewBatchForm(maxRequests int, batchPath string, batchable func(method, path string) bool) *Batch {
+	return &Batch{
+		maxRequests: maxRequests,
+		batchPath:   batchPath,
+		batchable:   batchable,
+	}
+}
+
+func (f *Batch) ParseAndValidate(r *http.Request) (form.Former, response.Error) {
+	var req Request
+	if err := form.BindJSON(r, &req); err != nil {
+		return nil, err
+	}
+
+	errors := make(map[string]response.ErrorMessage)
+	if len(req.Requests) == 0 {
+		errors["requests"] = response.MissedValueError("requests is required")
+	}
+	if len(req.Requests) > f.maxRequests {
+		errors["requests"] = response.WrongValueError(fmt.Sprintf("should contain less or equal than %d items", f.maxRequests))
+	}
+
+	for i := range req.Requests {
+		f.validateSubRequest(i, &req.Requests[i], errors)
+	}
+
+	if len(errors) > 0 {
+		return nil, response.NewValidationError(errors)
+	}
+
+	f.Requests = req.Requests
+
+	return f, nil
+}
+
+func (f *Batch) validateSubRequest(i int, sub *SubRequest, errors map[string]response.ErrorMessage) {
+	key := fmt.Sprintf("requests.%d", i)
+
+	sub.Method = strings.ToUpper(strings.TrimSpace(sub.Method))
+	if sub.Method == "" {
+		sub.Method = http.MethodGet
+	}
+	if !contains(supportedMethods, sub.Method) {
+		errors[key+".method"] = response.ErrorMessage{
+			Code:    errs.UnsupportedValue,
+			Message: fmt.Sprintf("should be one of: %s", strings.Join(supportedMethods, ", ")),
+		}
+	}
+
+	path, _, _ := strings.Cut(sub.Path, "?")
+	switch {
+	case path == "":
+		errors[key+".path"] = response.MissedValueError("path is required")
+	case !strings.HasPrefix(path, "/v1/") && !strings.HasPrefix(path, "/v2/"):
+		errors[key+".path"] = response.WrongFormatError("should start with /v1/ or /v2/")
+	case path == f.batchPath:
+		errors[key+".path"] = response.WrongValueError("batch requests can not be nested")
+	case !f.batchable(sub.Method, sub.Path):
+		errors[key+".path"] = response.WrongValueError("streamed and non-JSON routes can not be batched")
+	}
+}
+
+func (f *Batch) ConvertToMap() map[string]interface{} {
+	return map[string]interface{}{
+		"requests": len(f.Requests),
+	}
+}
+
+func contains(list []string, value string) bool {
+	for _, v := range list {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
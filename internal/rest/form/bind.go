@@ -0,0 +1,251 @@
+package form
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/goverland-labs/goverland-core-web-api/internal/response"
+	"github.com/goverland-labs/goverland-core-web-api/internal/response/errs"
+)
+
+// BindQuery fills the fields of dst tagged with `query` from the request values and validates them
+// according to the `validate` tag. Empty values are skipped unless the field has a `default` tag.
+// Comma separated lists are bound to []string fields, rules are applied to each element.
+// Embedded structs without tags, like common.Pagination, are bound recursively.
+func BindQuery(r *http.Request, dst any) response.Error {
+	errors := make(map[string]response.ErrorMessage)
+
+	bindQueryStruct(r, reflect.ValueOf(dst).Elem(), errors)
+
+	if len(errors) > 0 {
+		return response.NewValidationError(errors)
+	}
+
+	return nil
+}
+
+// BindJSON decodes the request body to dst and validates the fields tagged with `validate`.
+func BindJSON(r *http.Request, dst any) response.Error {
+	if err := json.NewDecoder(r.Body).Decode(dst); err != nil {
+		ve := response.NewValidationError()
+		ve.SetError(response.GeneralErrorKey, errs.InvalidRequestStructure, "invalid request structure")
+
+		return ve
+	}
+
+	errors := make(map[string]response.ErrorMessage)
+
+	validateJSONStruct(reflect.ValueOf(dst).Elem(), errors)
+
+	if len(errors) > 0 {
+		return response.NewValidationError(errors)
+	}
+
+	return nil
+}
+
+func bindQueryStruct(r *http.Request, v reflect.Value, errors map[string]response.ErrorMessage) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name := field.Tag.Get(TagQuery)
+
+		if field.Anonymous && name == "" && field.Type.Kind() == reflect.Struct {
+			bindQueryStruct(r, v.Field(i), errors)
+
+			continue
+		}
+
+		if name == "" || !field.IsExported() {
+			continue
+		}
+
+		rules := ParseRules(field.Tag.Get(TagValidate))
+		raw := strings.TrimSpace(r.FormValue(name))
+		if raw == "" {
+			raw = field.Tag.Get(TagDefault)
+		}
+
+		if raw == "" {
+			if rules.Required {
+				errors[name] = response.MissedValueError(fmt.Sprintf("%s is required", name))
+			}
+
+			continue
+		}
+
+		bindValue(v.Field(i), name, raw, rules, errors)
+	}
+}
+
+// bindValue converts raw to the field type, returns false if the value is not valid.
+func bindValue(fv reflect.Value, name, raw string, rules Rules, errors map[string]response.ErrorMessage) bool {
+	if fv.Kind() != reflect.Pointer {
+		return setValue(fv, name, raw, rules, errors)
+	}
+
+	target := reflect.New(fv.Type().Elem())
+	if !setValue(target.Elem(), name, raw, rules, errors) {
+		return false
+	}
+	fv.Set(target)
+
+	return true
+}
+
+func setValue(fv reflect.Value, name, raw string, rules Rules, errors map[string]response.ErrorMessage) bool {
+	switch fv.Kind() {
+	case reflect.String:
+		if msg := rules.checkString(raw); msg != nil {
+			errors[name] = *msg
+
+			return false
+		}
+		fv.SetString(raw)
+
+	case reflect.Slice:
+		if fv.Type().Elem().Kind() != reflect.String {
+			panic(fmt.Sprintf("form: unsupported list type %s of %q", fv.Type(), name))
+		}
+
+		parts := strings.Split(raw, ",")
+		list := reflect.MakeSlice(fv.Type(), 0, len(parts))
+		valid := true
+		for i, part := range parts {
+			key := fmt.Sprintf("%s.%d", name, i)
+			item := strings.TrimSpace(part)
+			if item == "" {
+				errors[key] = response.WrongValueError("wrong value")
+				valid = false
+
+				continue
+			}
+
+			if msg := rules.checkString(item); msg != nil {
+				errors[key] = *msg
+				valid = false
+
+				continue
+			}
+
+			list = reflect.Append(list, reflect.ValueOf(item).Convert(fv.Type().Elem()))
+		}
+		fv.Set(list)
+
+		return valid
+
+	case reflect.Bool:
+		value, err := strconv.ParseBool(raw)
+		if err != nil {
+			errors[name] = response.WrongFormatError("should be boolean")
+
+			return false
+		}
+		fv.SetBool(value)
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		value, err := strconv.ParseInt(raw, 10, 64) // nolint:gomnd
+		if err != nil {
+			errors[name] = response.WrongFormatError("should be integer")
+
+			return false
+		}
+
+		if msg := numberRules(fv.Kind(), rules).checkNumber(value); msg != nil {
+			errors[name] = *msg
+
+			return false
+		}
+
+		if fv.CanUint() {
+			fv.SetUint(uint64(value))
+		} else {
+			fv.SetInt(value)
+		}
+
+	default:
+		panic(fmt.Sprintf("form: unsupported field type %s of %q", fv.Type(), name))
+	}
+
+	return true
+}
+
+// numberRules adds implicit lower bound for unsigned types.
+func numberRules(kind reflect.Kind, rules Rules) Rules {
+	if rules.Min != nil {
+		return rules
+	}
+
+	switch kind {
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		var zero int64
+		rules.Min = &zero
+	}
+
+	return rules
+}
+
+func validateJSONStruct(v reflect.Value, errors map[string]response.ErrorMessage) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get(TagValidate)
+		if tag == "" || !field.IsExported() {
+			continue
+		}
+
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "" {
+			name = field.Name
+		}
+
+		rules := ParseRules(tag)
+		fv := v.Field(i)
+		if fv.Kind() == reflect.Pointer {
+			if fv.IsNil() {
+				if rules.Required {
+					errors[name] = response.MissedValueError(fmt.Sprintf("%s is required", name))
+				}
+
+				continue
+			}
+			fv = fv.Elem()
+		}
+
+		if fv.IsZero() {
+			if rules.Required {
+				errors[name] = response.MissedValueError(fmt.Sprintf("%s is required", name))
+			}
+
+			continue
+		}
+
+		var msg *response.ErrorMessage
+		switch {
+		case fv.Kind() == reflect.String:
+			msg = rules.checkString(fv.String())
+		case fv.Kind() == reflect.Slice && fv.Type().Elem().Kind() == reflect.Uint8:
+			// json.RawMessage and other byte slices
+			msg = rules.checkString(string(fv.Bytes()))
+		case fv.Kind() == reflect.Slice && fv.Type().Elem().Kind() == reflect.String:
+			for j := 0; j < fv.Len(); j++ {
+				if itemMsg := rules.checkString(fv.Index(j).String()); itemMsg != nil {
+					errors[fmt.Sprintf("%s.%d", name, j)] = *itemMsg
+				}
+			}
+		case fv.CanInt():
+			msg = rules.checkNumber(fv.Int())
+		case fv.CanUint():
+			msg = rules.checkNumber(int64(fv.Uint()))
+		}
+
+		if msg != nil {
+			errors[name] = *msg
+		}
+	}
+}
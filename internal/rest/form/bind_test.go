@@ -0,0 +1,234 @@
+package form_test
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/goverland-labs/goverland-core-web-api/internal/helpers"
+	"github.com/goverland-labs/goverland-core-web-api/internal/response"
+	"github.com/goverland-labs/goverland-core-web-api/internal/response/errs"
+	"github.com/goverland-labs/goverland-core-web-api/internal/rest/form"
+	"github.com/goverland-labs/goverland-core-web-api/internal/rest/form/common"
+	"github.com/goverland-labs/goverland-core-web-api/internal/rest/form/dao"
+	"github.com/goverland-labs/goverland-core-web-api/internal/rest/form/proposal"
+	"github.com/goverland-labs/goverland-core-web-api/internal/rest/form/subscribe"
+)
+
+type parser interface {
+	ParseAndValidate(r *http.Request) (form.Former, response.Error)
+}
+
+func TestParseAndValidate_Errors(t *testing.T) {
+	webhookURLs := helpers.NewWebhookURLValidator(helpers.WebhookURLPolicy{}, net.DefaultResolver)
+
+	for name, tc := range map[string]struct {
+		form   parser
+		method string
+		target string
+		body   string
+		key    string
+		code   errs.ErrCode
+	}{
+		"negative offset": {
+			form:   common.NewPagination(),
+			target: "/?offset=-1",
+			key:    "offset",
+			code:   errs.WrongValue,
+		},
+		"zero limit": {
+			form:   common.NewPagination(),
+			target: "/?limit=0",
+			key:    "limit",
+			code:   errs.WrongValue,
+		},
+		"limit is not a number": {
+			form:   common.NewPagination(),
+			target: "/?limit=ten",
+			key:    "limit",
+			code:   errs.WrongFormat,
+		},
+		"unknown sort key": {
+			form:   dao.NewGetDelegatesForm(nil),
+			target: "/?by=name",
+			key:    "by",
+			code:   errs.UnsupportedValue,
+		},
+		"unknown delegation type": {
+			form:   dao.NewGetDelegatesV2Form(),
+			target: "/?delegation_type=unknown",
+			key:    "delegation_type",
+			code:   errs.UnsupportedValue,
+		},
+		"missed delegate address": {
+			form:   dao.NewGetDelegateProfileForm(nil),
+			target: "/",
+			key:    "address",
+			code:   errs.MissedValue,
+		},
+		"delegate is neither address nor ens name": {
+			form:   dao.NewGetDelegateProfileForm(nil),
+			target: "/?address=vitalik",
+			key:    "address",
+			code:   errs.WrongFormat,
+		},
+		"voter with invalid checksum": {
+			form:   proposal.NewValidateVoteForm(nil),
+			method: http.MethodPost,
+			body:   `{"voter":"0x329C54289Ff5D6B7b7daE13592C6B1EDA1543eD4"}`,
+			key:    "voter",
+			code:   errs.WrongFormat,
+		},
+		"bad dao identifier in the list": {
+			form:   dao.NewGetListForm(),
+			target: "/?daos=0b8c4ac4-9c6b-4bd0-9b55-9b3b2ef8a1a7,bad",
+			key:    "daos.1",
+			code:   errs.WrongFormat,
+		},
+		"empty item in the list": {
+			form:   proposal.NewGetListForm(nil),
+			target: "/?proposals=a,,b",
+			key:    "proposals.1",
+			code:   errs.WrongValue,
+		},
+		"only_active is not a boolean": {
+			form:   proposal.NewGetListForm(nil),
+			target: "/?only_active=yes",
+			key:    "only_active",
+			code:   errs.WrongFormat,
+		},
+		"unsupported proposal state": {
+			form:   proposal.NewGetListForm(nil),
+			target: "/?state=active,draft",
+			key:    "state.1",
+			code:   errs.UnsupportedValue,
+		},
+		"unsupported proposal sort": {
+			form:   proposal.NewGetListForm(nil),
+			target: "/?sort=oldest",
+			key:    "sort",
+			code:   errs.UnsupportedValue,
+		},
+		"reversed end range": {
+			form:   proposal.NewGetListForm(nil),
+			target: "/?end_from=1707048000&end_to=1706788800",
+			key:    "end_to",
+			code:   errs.WrongValue,
+		},
+		"invalid cursor": {
+			form:   proposal.NewGetVotesForm(nil),
+			target: "/?cursor=not-a-cursor",
+			key:    "cursor",
+			code:   errs.WrongFormat,
+		},
+		"invalid json": {
+			form:   subscribe.NewSubscribeOnDaoForm(),
+			method: http.MethodPost,
+			body:   `{"dao":`,
+			key:    response.GeneralErrorKey,
+			code:   errs.InvalidRequestStructure,
+		},
+		"missed dao": {
+			form:   subscribe.NewSubscribeOnDaoForm(),
+			method: http.MethodPost,
+			body:   `{}`,
+			key:    "dao",
+			code:   errs.MissedValue,
+		},
+		"dao is not uuid": {
+			form:   subscribe.NewSubscribeOnDaoForm(),
+			method: http.MethodPost,
+			body:   `{"dao":"aave.eth"}`,
+			key:    "dao",
+			code:   errs.WrongFormat,
+		},
+		"webhook is not url": {
+			form:   subscribe.NewSubscribeForm(webhookURLs),
+			method: http.MethodPost,
+			body:   `{"webhook_url":"localhost"}`,
+			key:    "webhook_url",
+			code:   errs.WrongFormat,
+		},
+		"webhook points to loopback": {
+			form:   subscribe.NewSubscribeForm(webhookURLs),
+			method: http.MethodPost,
+			body:   `{"webhook_url":"http://127.0.0.1:8080/hook"}`,
+			key:    "webhook_url",
+			code:   errs.WrongValue,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			method, target := tc.method, tc.target
+			if method == "" {
+				method = http.MethodGet
+			}
+			if target == "" {
+				target = "/"
+			}
+
+			r := httptest.NewRequest(method, target, strings.NewReader(tc.body))
+			_, err := tc.form.ParseAndValidate(r)
+			if err == nil {
+				t.Fatal("expected validation error")
+			}
+
+			verr, ok := err.(*response.ValidationError)
+			if !ok {
+				t.Fatalf("expected validation error, got %T", err)
+			}
+
+			msg, ok := verr.Errors()[tc.key]
+			if !ok {
+				t.Fatalf("expected error for %q, got %v", tc.key, verr.Errors())
+			}
+			if msg.Code != tc.code {
+				t.Errorf("expected code %d, got %d (%s)", tc.code, msg.Code, msg.Message)
+			}
+		})
+	}
+}
+
+func TestParseAndValidate_Defaults(t *testing.T) {
+	r := httptest.NewRequest(http.MethodGet, "/?by=voting_power&daos=+0b8c4ac4-9c6b-4bd0-9b55-9b3b2ef8a1a7+", nil)
+
+	f, err := dao.NewGetDelegatesForm(nil).ParseAndValidate(r)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	delegates := f.(*dao.GetDelegates)
+	if delegates.Offset != 0 || delegates.Limit != 20 {
+		t.Errorf("expected default pagination, got offset %d limit %d", delegates.Offset, delegates.Limit)
+	}
+	if delegates.By == nil || *delegates.By != "voting_power" {
+		t.Errorf("expected sort key to be bound, got %v", delegates.By)
+	}
+	if delegates.Query != nil {
+		t.Errorf("expected empty query to stay nil")
+	}
+
+	f, err = dao.NewGetListForm().ParseAndValidate(r)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if daos := f.(*dao.GetList).DAOs; len(daos) != 1 || daos[0] != "0b8c4ac4-9c6b-4bd0-9b55-9b3b2ef8a1a7" {
+		t.Errorf("expected trimmed dao list, got %v", daos)
+	}
+}
+
+func TestParseAndValidate_Cursor(t *testing.T) {
+	cursor := common.Cursor{Offset: 40, CursorKey: common.CursorKey{Created: 1706800000, ID: "0xvote"}}.Encode()
+	r := httptest.NewRequest(http.MethodGet, "/?offset=5&limit=10&cursor="+cursor, nil)
+
+	f, err := proposal.NewGetVotesForm(nil).ParseAndValidate(r)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	votes := f.(*proposal.GetVotes)
+	if votes.Offset != 40 || votes.After.ID != "0xvote" || votes.After.Created != 1706800000 || votes.Limit != 10 {
+		t.Errorf("expected cursor to replace the offset, got offset %d after %+v limit %d", votes.Offset, votes.After, votes.Limit)
+	}
+}
@@ -0,0 +1,72 @@
+package common
+
+import (
+	"encoding/base64"
+	"encoding/json"
+
+	"github.com/goverland-labs/goverland-core-web-api/internal/response"
+)
+
+// CursorKey is the creation time and the identifier of the last returned item, the next page continues right
+// after the item. Created is zero for the lists which are not ordered by the creation time.
+type CursorKey struct {
+	Created int64  `json:"c,omitempty"`
+	ID      string `json:"a,omitempty"`
+}
+
+// Cursor points to the position right after the last returned item. Offset is the position the item had when
+// the cursor was issued, it is the hint where to look for the item when rows were inserted or removed since then.
+type Cursor struct {
+	Offset uint64 `json:"o"`
+	CursorKey
+}
+
+// Encode returns the opaque representation of the cursor.
+func (c Cursor) Encode() string {
+	data, _ := json.Marshal(c)
+
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func DecodeCursor(value string) (Cursor, bool) {
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return Cursor{}, false
+	}
+
+	var c Cursor
+	if err := json.Unmarshal(data, &c); err != nil {
+		return Cursor{}, false
+	}
+
+	return c, true
+}
+
+// CursorPagination accepts the opaque cursor returned in the previous response in addition to offset and limit.
+// The cursor takes precedence over the offset.
+type CursorPagination struct {
+	Pagination
+
+	Cursor string `query:"cursor" doc:"Cursor returned in the X-Next-Cursor header of the previous page"`
+	// After is the key of the last item of the previous page, empty for offset requests
+	After CursorKey
+}
+
+// ApplyCursor decodes the bound cursor and replaces the offset with the position hint of the cursor.
+func (p *CursorPagination) ApplyCursor() response.Error {
+	if p.Cursor == "" {
+		return nil
+	}
+
+	c, ok := DecodeCursor(p.Cursor)
+	if !ok {
+		return response.NewValidationError(map[string]response.ErrorMessage{
+			"cursor": response.WrongFormatError("invalid cursor"),
+		})
+	}
+
+	p.Offset = c.Offset
+	p.After = c.CursorKey
+
+	return nil
+}
@@ -0,0 +1,33 @@
+package common
+
+import (
+	"net/http"
+
+	"github.com/goverland-labs/goverland-core-web-api/internal/response"
+	"github.com/goverland-labs/goverland-core-web-api/internal/rest/form"
+)
+
+// ExportUserVotes has the filters of the user votes list, all matching votes are exported.
+type ExportUserVotes struct {
+	Proposals []string `query:"proposals" doc:"Proposal identifiers"`
+	DaoID     *string  `query:"dao_id" validate:"uuid" doc:"DAO identifier"`
+}
+
+func NewExportUserVotesForm() *ExportUserVotes {
+	return &ExportUserVotes{}
+}
+
+func (f *ExportUserVotes) ParseAndValidate(r *http.Request) (form.Former, response.Error) {
+	if err := form.BindQuery(r, f); err != nil {
+		return nil, err
+	}
+
+	return f, nil
+}
+
+func (f *ExportUserVotes) ConvertToMap() map[string]interface{} {
+	return map[string]interface{}{
+		"proposals": f.Proposals,
+		"dao_id":    f.DaoID,
+	}
+}
@@ -0,0 +1,80 @@
+package common
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/goverland-labs/goverland-core-web-api/internal/response"
+	"github.com/goverland-labs/goverland-core-web-api/internal/response/errs"
+	"github.com/goverland-labs/goverland-core-web-api/internal/rest/fieldset"
+)
+
+const IncludeDao = "dao"
+
+// Fieldset limits the response to the listed top level fields.
+type Fieldset struct {
+	Fields []string `query:"fields" doc:"Top level fields to return, all fields by default"`
+}
+
+// CheckFields validates the requested fields against JSON names of the response model.
+func (f *Fieldset) CheckFields(model any) response.Error {
+	if len(f.Fields) == 0 {
+		return nil
+	}
+
+	names := fieldset.Names(model)
+	known := make(map[string]struct{}, len(names))
+	for _, name := range names {
+		known[name] = struct{}{}
+	}
+
+	errors := make(map[string]response.ErrorMessage)
+	for i, field := range f.Fields {
+		if _, ok := known[field]; !ok {
+			errors[fmt.Sprintf("fields.%d", i)] = response.ErrorMessage{
+				Code:    errs.UnsupportedValue,
+				Message: fmt.Sprintf("should be one of: %s", strings.Join(names, ", ")),
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return response.NewValidationError(errors)
+	}
+
+	return nil
+}
+
+// Only reports whether all requested fields are in the given set.
+func (f *Fieldset) Only(fields ...string) bool {
+	if len(f.Fields) == 0 {
+		return false
+	}
+
+	for _, field := range f.Fields {
+		if !contains(fields, field) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Include lists related objects to embed into the response items.
+type Include struct {
+	Include []string `query:"include" validate:"oneof=dao" doc:"Related objects to embed"`
+}
+
+func (i *Include) Has(name string) bool {
+	return contains(i.Include, name)
+}
+
+func contains(list []string, value string) bool {
+	for _, v := range list {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,33 @@
+package common
+
+import (
+	"net/http"
+
+	"github.com/goverland-labs/goverland-core-web-api/internal/response"
+	"github.com/goverland-labs/goverland-core-web-api/internal/rest/form"
+)
+
+// GetUserParticipatedDaos keeps returning the whole list when the limit is not set.
+type GetUserParticipatedDaos struct {
+	Offset uint64  `query:"offset" default:"0" doc:"Number of items to skip"`
+	Limit  *uint64 `query:"limit" validate:"min=1" doc:"Max number of items to return, all items by default"`
+}
+
+func NewGetUserParticipatedDaosForm() *GetUserParticipatedDaos {
+	return &GetUserParticipatedDaos{}
+}
+
+func (f *GetUserParticipatedDaos) ParseAndValidate(r *http.Request) (form.Former, response.Error) {
+	if err := form.BindQuery(r, f); err != nil {
+		return nil, err
+	}
+
+	return f, nil
+}
+
+func (f *GetUserParticipatedDaos) ConvertToMap() map[string]interface{} {
+	return map[string]interface{}{
+		"offset": f.Offset,
+		"limit":  f.Limit,
+	}
+}
@@ -0,0 +1,32 @@
+package common
+
+import (
+	"net/http"
+
+	"github.com/goverland-labs/goverland-core-web-api/internal/response"
+	"github.com/goverland-labs/goverland-core-web-api/internal/rest/form"
+)
+
+type GetUserProfile struct {
+	Votes uint64 `query:"votes" default:"5" validate:"min=1,max=50" doc:"Max number of recent votes"`
+	Daos  uint64 `query:"daos" default:"20" validate:"min=1,max=100" doc:"Max number of DAOs the user voted in"`
+}
+
+func NewGetUserProfileForm() *GetUserProfile {
+	return &GetUserProfile{}
+}
+
+func (f *GetUserProfile) ParseAndValidate(r *http.Request) (form.Former, response.Error) {
+	if err := form.BindQuery(r, f); err != nil {
+		return nil, err
+	}
+
+	return f, nil
+}
+
+func (f *GetUserProfile) ConvertToMap() map[string]interface{} {
+	return map[string]interface{}{
+		"votes": f.Votes,
+		"daos":  f.Daos,
+	}
+}
@@ -1,19 +1,20 @@
 package common
 
 import (
-	"fmt"
 	"net/http"
-	"strings"
 
 	"github.com/goverland-labs/goverland-core-web-api/internal/response"
 	"github.com/goverland-labs/goverland-core-web-api/internal/rest/form"
+	"github.com/goverland-labs/goverland-core-web-api/internal/rest/models/proposal"
 )
 
 type GetUserVotes struct {
-	Pagination
+	CursorPagination
+	Fieldset
+	Include
 
-	Proposals []string
-	DaoID     *string
+	Proposals []string `query:"proposals" doc:"Proposal identifiers"`
+	DaoID     *string  `query:"dao_id" validate:"uuid" doc:"DAO identifier"`
 }
 
 func NewGetUserVotesForm() *GetUserVotes {
@@ -21,54 +22,25 @@ func NewGetUserVotesForm() *GetUserVotes {
 }
 
 func (f *GetUserVotes) ParseAndValidate(r *http.Request) (form.Former, response.Error) {
-	errors := make(map[string]response.ErrorMessage)
-
-	f.ValidateAndSetPagination(r, errors)
-	f.validateAndSetProposalIds(r, errors)
-	f.validateAndSetDaoID(r)
-
-	if len(errors) > 0 {
-		ve := response.NewValidationError(errors)
-
-		return nil, ve
+	if err := form.BindQuery(r, f); err != nil {
+		return nil, err
 	}
 
-	return f, nil
-}
-
-func (f *GetUserVotes) validateAndSetProposalIds(r *http.Request, errors map[string]response.ErrorMessage) {
-	pr := strings.TrimSpace(r.FormValue("proposals"))
-	if pr == "" {
-		return
+	if err := f.CheckFields(proposal.Vote{}); err != nil {
+		return nil, err
 	}
 
-	ids := strings.Split(pr, ",")
-	proposals := make([]string, 0, len(ids))
-	for i := range ids {
-		id := strings.TrimSpace(ids[i])
-		if id == "" {
-			errors[fmt.Sprintf("proposals.%d", i)] = response.WrongValueError("wrong value")
-			continue
-		}
-
-		proposals = append(proposals, id)
+	if err := f.ApplyCursor(); err != nil {
+		return nil, err
 	}
 
-	f.Proposals = proposals
+	return f, nil
 }
 
 func (f *GetUserVotes) ConvertToMap() map[string]interface{} {
 	return map[string]interface{}{
 		"offset": f.Offset,
 		"limit":  f.Limit,
+		"cursor": f.Cursor,
 	}
 }
-
-func (f *GetUserVotes) validateAndSetDaoID(r *http.Request) {
-	daoID := strings.TrimSpace(r.FormValue("dao_id"))
-	if daoID == "" {
-		return
-	}
-
-	f.DaoID = &daoID
-}
@@ -2,47 +2,39 @@ package dao
 
 import (
 	"net/http"
-	"strings"
 
+	ihelpers "github.com/goverland-labs/goverland-core-web-api/internal/helpers"
 	"github.com/goverland-labs/goverland-core-web-api/internal/response"
 	"github.com/goverland-labs/goverland-core-web-api/internal/rest/form"
 )
 
-type GetDelegateProfileRequest struct {
-	Address        string
-	DelegationType string
-	ChainID        string
-}
-
 type GetDelegateProfile struct {
-	Address        string
-	DelegationType *string
-	ChainID        *string
+	Address        string  `query:"address" validate:"required,identifier" doc:"Delegate address or ENS name"`
+	DelegationType *string `query:"delegation_type" validate:"oneof=delegation|split-delegation|erc20-votes" doc:"Delegation type"`
+	ChainID        *string `query:"chain_id" doc:"Chain identifier"`
+
+	// Resolved is the delegate identifier given in the request
+	Resolved *ihelpers.ResolvedIdentifier
+	resolver *ihelpers.IdentifierResolver
 }
 
-func NewGetDelegateProfileForm() *GetDelegateProfile {
-	return &GetDelegateProfile{}
+func NewGetDelegateProfileForm(resolver *ihelpers.IdentifierResolver) *GetDelegateProfile {
+	return &GetDelegateProfile{resolver: resolver}
 }
 
 func (f *GetDelegateProfile) ParseAndValidate(r *http.Request) (form.Former, response.Error) {
-	errors := make(map[string]response.ErrorMessage)
-
-	req := GetDelegateProfileRequest{
-		Address:        r.FormValue("address"),
-		DelegationType: r.FormValue("delegation_type"),
-		ChainID:        r.FormValue("chain_id"),
+	if err := form.BindQuery(r, f); err != nil {
+		return nil, err
 	}
 
-	f.validateAndSetAddress(req, errors)
-	f.validateAndSetDelegationType(req, errors)
-	f.validateAndSetChainID(req, errors)
-
-	if len(errors) > 0 {
-		ve := response.NewValidationError(errors)
-
-		return nil, ve
+	resolved, verr := form.ResolveIdentifier(r.Context(), f.resolver, "address", f.Address)
+	if verr != nil {
+		return nil, verr
 	}
 
+	f.Address = resolved.Address
+	f.Resolved = resolved
+
 	return f, nil
 }
 
@@ -53,31 +45,3 @@ func (f *GetDelegateProfile) ConvertToMap() map[string]interface{} {
 		"chain_id":        f.ChainID,
 	}
 }
-
-func (f *GetDelegateProfile) validateAndSetAddress(req GetDelegateProfileRequest, errors map[string]response.ErrorMessage) {
-	address := strings.TrimSpace(req.Address)
-	if address == "" {
-		errors["address"] = response.MissedValueError("address is required")
-		return
-	}
-
-	f.Address = address
-}
-
-func (f *GetDelegateProfile) validateAndSetDelegationType(req GetDelegateProfileRequest, _ map[string]response.ErrorMessage) {
-	val := strings.TrimSpace(req.DelegationType)
-	if val == "" {
-		return
-	}
-
-	f.DelegationType = &val
-}
-
-func (f *GetDelegateProfile) validateAndSetChainID(req GetDelegateProfileRequest, _ map[string]response.ErrorMessage) {
-	value := strings.TrimSpace(req.ChainID)
-	if value == "" {
-		return
-	}
-
-	f.ChainID = &value
-}
@@ -2,53 +2,43 @@ package dao
 
 import (
 	"net/http"
-	"strings"
 
+	ihelpers "github.com/goverland-labs/goverland-core-web-api/internal/helpers"
 	"github.com/goverland-labs/goverland-core-web-api/internal/response"
 	"github.com/goverland-labs/goverland-core-web-api/internal/rest/form"
 	helpers "github.com/goverland-labs/goverland-core-web-api/internal/rest/form/common"
 )
 
-type GetDelegatesRequest struct {
-	Query          string
-	By             string
-	DelegationType string
-	ChainID        string
-}
-
 type GetDelegates struct {
 	helpers.Pagination
 
-	Query          *string
-	By             *string
-	DelegationType *string
-	ChainID        *string
+	Query          *string `query:"query" doc:"Delegate address or ENS name"`
+	By             *string `query:"by" validate:"oneof=delegator_count|voting_power|votes_count|created_proposals_count" doc:"Sort field"`
+	DelegationType *string `query:"delegation_type" validate:"oneof=delegation|split-delegation|erc20-votes" doc:"Delegation type"`
+	ChainID        *string `query:"chain_id" doc:"Chain identifier"`
+
+	// Resolved is the delegate identifier given as ENS name in the query
+	Resolved *ihelpers.ResolvedIdentifier
+	resolver *ihelpers.IdentifierResolver
 }
 
-func NewGetDelegatesForm() *GetDelegates {
-	return &GetDelegates{}
+func NewGetDelegatesForm(resolver *ihelpers.IdentifierResolver) *GetDelegates {
+	return &GetDelegates{resolver: resolver}
 }
 
 func (f *GetDelegates) ParseAndValidate(r *http.Request) (form.Former, response.Error) {
-	errors := make(map[string]response.ErrorMessage)
-
-	req := GetDelegatesRequest{
-		Query:          r.FormValue("query"),
-		By:             r.FormValue("by"),
-		DelegationType: r.FormValue("delegation_type"),
-		ChainID:        r.FormValue("chain_id"),
+	if err := form.BindQuery(r, f); err != nil {
+		return nil, err
 	}
 
-	f.validateAndSetQuery(req, errors)
-	f.validateAndSetBy(req, errors)
-	f.validateAndSetDelegationType(req, errors)
-	f.validateAndSetChainID(req, errors)
-	f.ValidateAndSetPagination(r, errors)
-
-	if len(errors) > 0 {
-		ve := response.NewValidationError(errors)
+	if f.Query != nil && form.IsEnsName(*f.Query) {
+		resolved, verr := form.ResolveIdentifier(r.Context(), f.resolver, "query", *f.Query)
+		if verr != nil {
+			return nil, verr
+		}
 
-		return nil, ve
+		f.Query = &resolved.Address
+		f.Resolved = resolved
 	}
 
 	return f, nil
@@ -62,39 +52,3 @@ func (f *GetDelegates) ConvertToMap() map[string]interface{} {
 		"limit":  f.Limit,
 	}
 }
-
-func (f *GetDelegates) validateAndSetQuery(req GetDelegatesRequest, _ map[string]response.ErrorMessage) {
-	query := strings.TrimSpace(req.Query)
-	if query == "" {
-		return
-	}
-
-	f.Query = &query
-}
-
-func (f *GetDelegates) validateAndSetBy(req GetDelegatesRequest, _ map[string]response.ErrorMessage) {
-	by := strings.TrimSpace(req.By)
-	if by == "" {
-		return
-	}
-
-	f.By = &by
-}
-
-func (f *GetDelegates) validateAndSetDelegationType(req GetDelegatesRequest, _ map[string]response.ErrorMessage) {
-	val := strings.TrimSpace(req.DelegationType)
-	if val == "" {
-		return
-	}
-
-	f.DelegationType = &val
-}
-
-func (f *GetDelegates) validateAndSetChainID(req GetDelegatesRequest, _ map[string]response.ErrorMessage) {
-	value := strings.TrimSpace(req.ChainID)
-	if value == "" {
-		return
-	}
-
-	f.ChainID = &value
-}
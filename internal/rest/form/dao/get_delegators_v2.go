@@ -2,7 +2,6 @@ package dao
 
 import (
 	"net/http"
-	"strings"
 
 	"github.com/goverland-labs/goverland-core-web-api/internal/response"
 	"github.com/goverland-labs/goverland-core-web-api/internal/rest/form"
@@ -12,9 +11,9 @@ import (
 type GetDelegatorsV2 struct {
 	helpers.Pagination
 
-	Query          *string
-	DelegationType *string
-	ChainID        *string
+	Query          *string `query:"query" doc:"Delegator address"`
+	DelegationType *string `query:"delegation_type" validate:"oneof=delegation|split-delegation|erc20-votes" doc:"Delegation type"`
+	ChainID        *string `query:"chain_id" doc:"Chain identifier"`
 }
 
 func NewGetDelegatorsV2Form() *GetDelegatorsV2 {
@@ -22,23 +21,8 @@ func NewGetDelegatorsV2Form() *GetDelegatorsV2 {
 }
 
 func (f *GetDelegatorsV2) ParseAndValidate(r *http.Request) (form.Former, response.Error) {
-	errors := make(map[string]response.ErrorMessage)
-
-	req := GetDelegatesRequest{
-		Query:          r.FormValue("query"),
-		DelegationType: r.FormValue("delegation_type"),
-		ChainID:        r.FormValue("chain_id"),
-	}
-
-	f.validateAndSetQuery(req, errors)
-	f.validateAndSetDelegationType(req, errors)
-	f.validateAndSetChainID(req, errors)
-	f.ValidateAndSetPagination(r, errors)
-
-	if len(errors) > 0 {
-		ve := response.NewValidationError(errors)
-
-		return nil, ve
+	if err := form.BindQuery(r, f); err != nil {
+		return nil, err
 	}
 
 	return f, nil
@@ -51,30 +35,3 @@ func (f *GetDelegatorsV2) ConvertToMap() map[string]interface{} {
 		"limit":  f.Limit,
 	}
 }
-
-func (f *GetDelegatorsV2) validateAndSetQuery(req GetDelegatesRequest, _ map[string]response.ErrorMessage) {
-	query := strings.TrimSpace(req.Query)
-	if query == "" {
-		return
-	}
-
-	f.Query = &query
-}
-
-func (f *GetDelegatorsV2) validateAndSetDelegationType(req GetDelegatesRequest, _ map[string]response.ErrorMessage) {
-	val := strings.TrimSpace(req.DelegationType)
-	if val == "" {
-		return
-	}
-
-	f.DelegationType = &val
-}
-
-func (f *GetDelegatorsV2) validateAndSetChainID(req GetDelegatesRequest, _ map[string]response.ErrorMessage) {
-	value := strings.TrimSpace(req.ChainID)
-	if value == "" {
-		return
-	}
-
-	f.ChainID = &value
-}
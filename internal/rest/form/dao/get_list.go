@@ -1,29 +1,22 @@
 package dao
 
 import (
-	"fmt"
 	"net/http"
-	"strings"
 
 	"github.com/goverland-labs/goverland-core-web-api/internal/response"
 	"github.com/goverland-labs/goverland-core-web-api/internal/rest/form"
 	helpers "github.com/goverland-labs/goverland-core-web-api/internal/rest/form/common"
+	model "github.com/goverland-labs/goverland-core-web-api/internal/rest/models/dao"
 )
 
-type GetListRequest struct {
-	Query       string
-	Category    string
-	DAOs        string
-	FungibleIDs string
-}
-
 type GetList struct {
 	helpers.Pagination
+	helpers.Fieldset
 
-	Query       *string
-	Category    *string
-	DAOs        []string
-	FungibleIDs []string
+	Query       *string  `query:"query" doc:"Search by name"`
+	Category    *string  `query:"category" doc:"Category"`
+	DAOs        []string `query:"daos" validate:"uuid" doc:"DAO identifiers"`
+	FungibleIDs []string `query:"fungible_ids" doc:"Token fungible identifiers"`
 }
 
 func NewGetListForm() *GetList {
@@ -31,25 +24,12 @@ func NewGetListForm() *GetList {
 }
 
 func (f *GetList) ParseAndValidate(r *http.Request) (form.Former, response.Error) {
-	errors := make(map[string]response.ErrorMessage)
-
-	req := GetListRequest{
-		Query:       r.FormValue("query"),
-		Category:    r.FormValue("category"),
-		DAOs:        r.FormValue("daos"),
-		FungibleIDs: r.FormValue("fungible_ids"),
+	if err := form.BindQuery(r, f); err != nil {
+		return nil, err
 	}
 
-	f.validateAndSetQuery(req, errors)
-	f.validateAndSetCategory(req, errors)
-	f.validateAndSetDAOs(req, errors)
-	f.validateAndSetFungibleIDs(req, errors)
-	f.ValidateAndSetPagination(r, errors)
-
-	if len(errors) > 0 {
-		ve := response.NewValidationError(errors)
-
-		return nil, ve
+	if err := f.CheckFields(model.Dao{}); err != nil {
+		return nil, err
 	}
 
 	return f, nil
@@ -63,64 +43,3 @@ func (f *GetList) ConvertToMap() map[string]interface{} {
 		"limit":    f.Limit,
 	}
 }
-
-func (f *GetList) validateAndSetQuery(req GetListRequest, _ map[string]response.ErrorMessage) {
-	query := strings.TrimSpace(req.Query)
-	if query == "" {
-		return
-	}
-
-	f.Query = &query
-}
-
-func (f *GetList) validateAndSetCategory(req GetListRequest, _ map[string]response.ErrorMessage) {
-	category := strings.TrimSpace(req.Category)
-	if category == "" {
-		return
-	}
-
-	f.Category = &category
-}
-
-func (f *GetList) validateAndSetDAOs(req GetListRequest, errors map[string]response.ErrorMessage) {
-	idsString := strings.TrimSpace(req.DAOs)
-	if idsString == "" {
-		return
-	}
-
-	ids := strings.Split(idsString, ",")
-	daos := make([]string, 0, len(ids))
-	for i := range ids {
-		id := strings.TrimSpace(ids[i])
-		if id == "" {
-			errors[fmt.Sprintf("daos.%d", i)] = response.WrongValueError("wrong value")
-			continue
-		}
-
-		// TODO: uuid?
-		daos = append(daos, id)
-	}
-
-	f.DAOs = daos
-}
-
-func (f *GetList) validateAndSetFungibleIDs(req GetListRequest, errors map[string]response.ErrorMessage) {
-	idsString := strings.TrimSpace(req.FungibleIDs)
-	if idsString == "" {
-		return
-	}
-
-	ids := strings.Split(idsString, ",")
-	fungibleIDs := make([]string, 0, len(ids))
-	for i := range ids {
-		id := strings.TrimSpace(ids[i])
-		if id == "" {
-			errors[fmt.Sprintf("fungible_ids.%d", i)] = response.WrongValueError("wrong value")
-			continue
-		}
-
-		fungibleIDs = append(fungibleIDs, id)
-	}
-
-	f.FungibleIDs = fungibleIDs
-}
@@ -0,0 +1,34 @@
+package dao
+
+import (
+	"net/http"
+
+	"github.com/goverland-labs/goverland-core-web-api/internal/response"
+	"github.com/goverland-labs/goverland-core-web-api/internal/rest/form"
+)
+
+type GetOverview struct {
+	Proposals uint64 `query:"proposals" default:"5" validate:"min=1,max=50" doc:"Max number of active proposals"`
+	Delegates uint64 `query:"delegates" default:"5" validate:"min=1,max=50" doc:"Max number of top delegates"`
+	Feed      uint64 `query:"feed" default:"10" validate:"min=1,max=50" doc:"Max number of feed items"`
+}
+
+func NewGetOverviewForm() *GetOverview {
+	return &GetOverview{}
+}
+
+func (f *GetOverview) ParseAndValidate(r *http.Request) (form.Former, response.Error) {
+	if err := form.BindQuery(r, f); err != nil {
+		return nil, err
+	}
+
+	return f, nil
+}
+
+func (f *GetOverview) ConvertToMap() map[string]interface{} {
+	return map[string]interface{}{
+		"proposals": f.Proposals,
+		"delegates": f.Delegates,
+		"feed":      f.Feed,
+	}
+}
@@ -17,14 +17,8 @@ func NewGetTopForm() *GetTop {
 }
 
 func (f *GetTop) ParseAndValidate(r *http.Request) (form.Former, response.Error) {
-	errors := make(map[string]response.ErrorMessage)
-
-	f.ValidateAndSetPagination(r, errors)
-
-	if len(errors) > 0 {
-		ve := response.NewValidationError(errors)
-
-		return nil, ve
+	if err := form.BindQuery(r, f); err != nil {
+		return nil, err
 	}
 
 	return f, nil
@@ -2,7 +2,6 @@ package dao
 
 import (
 	"net/http"
-	"strings"
 
 	"github.com/goverland-labs/goverland-core-web-api/internal/response"
 	"github.com/goverland-labs/goverland-core-web-api/internal/rest/form"
@@ -12,8 +11,8 @@ import (
 type GetUserDelegatesV2 struct {
 	helpers.Pagination
 
-	DelegationType *string
-	ChainID        *string
+	DelegationType *string `query:"delegation_type" validate:"oneof=delegation|split-delegation|erc20-votes" doc:"Delegation type"`
+	ChainID        *string `query:"chain_id" doc:"Chain identifier"`
 }
 
 func NewGetUserDelegatesV2Form() *GetUserDelegatesV2 {
@@ -21,22 +20,8 @@ func NewGetUserDelegatesV2Form() *GetUserDelegatesV2 {
 }
 
 func (f *GetUserDelegatesV2) ParseAndValidate(r *http.Request) (form.Former, response.Error) {
-	errors := make(map[string]response.ErrorMessage)
-
-	req := GetDelegatesRequest{
-		Query:          r.FormValue("query"),
-		DelegationType: r.FormValue("delegation_type"),
-		ChainID:        r.FormValue("chain_id"),
-	}
-
-	f.validateAndSetDelegationType(req, errors)
-	f.validateAndSetChainID(req, errors)
-	f.ValidateAndSetPagination(r, errors)
-
-	if len(errors) > 0 {
-		ve := response.NewValidationError(errors)
-
-		return nil, ve
+	if err := form.BindQuery(r, f); err != nil {
+		return nil, err
 	}
 
 	return f, nil
@@ -48,21 +33,3 @@ func (f *GetUserDelegatesV2) ConvertToMap() map[string]interface{} {
 		"limit":  f.Limit,
 	}
 }
-
-func (f *GetUserDelegatesV2) validateAndSetDelegationType(req GetDelegatesRequest, _ map[string]response.ErrorMessage) {
-	val := strings.TrimSpace(req.DelegationType)
-	if val == "" {
-		return
-	}
-
-	f.DelegationType = &val
-}
-
-func (f *GetUserDelegatesV2) validateAndSetChainID(req GetDelegatesRequest, _ map[string]response.ErrorMessage) {
-	value := strings.TrimSpace(req.ChainID)
-	if value == "" {
-		return
-	}
-
-	f.ChainID = &value
-}
@@ -2,25 +2,18 @@ package dao
 
 import (
 	"net/http"
-	"strings"
 
 	"github.com/goverland-labs/goverland-core-web-api/internal/response"
 	"github.com/goverland-labs/goverland-core-web-api/internal/rest/form"
 	helpers "github.com/goverland-labs/goverland-core-web-api/internal/rest/form/common"
 )
 
-type GetUserDelegatorsV2Request struct {
-	Query          string
-	DelegationType string
-	ChainID        string
-}
-
 type GetUserDelegatorsV2 struct {
 	helpers.Pagination
 
-	DelegationType *string
-	ChainID        *string
-	Query          *string
+	DelegationType *string `query:"delegation_type" validate:"oneof=delegation|split-delegation|erc20-votes" doc:"Delegation type"`
+	ChainID        *string `query:"chain_id" doc:"Chain identifier"`
+	Query          *string `query:"query" doc:"Delegator address"`
 }
 
 func NewGetUserDelegatorsV2Form() *GetUserDelegatorsV2 {
@@ -28,23 +21,8 @@ func NewGetUserDelegatorsV2Form() *GetUserDelegatorsV2 {
 }
 
 func (f *GetUserDelegatorsV2) ParseAndValidate(r *http.Request) (form.Former, response.Error) {
-	errors := make(map[string]response.ErrorMessage)
-
-	req := GetUserDelegatorsV2Request{
-		Query:          r.FormValue("query"),
-		DelegationType: r.FormValue("delegation_type"),
-		ChainID:        r.FormValue("chain_id"),
-	}
-
-	f.validateAndSetDelegationType(req, errors)
-	f.validateAndSetChainID(req, errors)
-	f.validateAndSetQuery(req, errors)
-	f.ValidateAndSetPagination(r, errors)
-
-	if len(errors) > 0 {
-		ve := response.NewValidationError(errors)
-
-		return nil, ve
+	if err := form.BindQuery(r, f); err != nil {
+		return nil, err
 	}
 
 	return f, nil
@@ -56,30 +34,3 @@ func (f *GetUserDelegatorsV2) ConvertToMap() map[string]interface{} {
 		"limit":  f.Limit,
 	}
 }
-
-func (f *GetUserDelegatorsV2) validateAndSetDelegationType(req GetUserDelegatorsV2Request, _ map[string]response.ErrorMessage) {
-	val := strings.TrimSpace(req.DelegationType)
-	if val == "" {
-		return
-	}
-
-	f.DelegationType = &val
-}
-
-func (f *GetUserDelegatorsV2) validateAndSetChainID(req GetUserDelegatorsV2Request, _ map[string]response.ErrorMessage) {
-	value := strings.TrimSpace(req.ChainID)
-	if value == "" {
-		return
-	}
-
-	f.ChainID = &value
-}
-
-func (f *GetUserDelegatorsV2) validateAndSetQuery(req GetUserDelegatorsV2Request, _ map[string]response.ErrorMessage) {
-	value := strings.TrimSpace(req.Query)
-	if value == "" {
-		return
-	}
-
-	f.Query = &value
-}
@@ -1,22 +1,18 @@
 package feed
 
 import (
-	"encoding/json"
 	"net/http"
 
 	"github.com/goverland-labs/goverland-core-web-api/internal/response"
-	"github.com/goverland-labs/goverland-core-web-api/internal/response/errs"
 	"github.com/goverland-labs/goverland-core-web-api/internal/rest/form"
 	helpers "github.com/goverland-labs/goverland-core-web-api/internal/rest/form/common"
 )
 
 type GetFeedRequest struct {
-	DaoList  []string `json:"dao_list"`
+	DaoList  []string `json:"dao_list" validate:"uuid"`
 	IsActive *bool    `json:"is_active,omitempty"`
 	Types    []string `json:"types"`
 	Actions  []string `json:"actions"`
-
-	helpers.Pagination
 }
 
 type GetFeedList struct {
@@ -25,7 +21,7 @@ type GetFeedList struct {
 	Types    []string
 	Actions  []string
 
-	helpers.Pagination
+	helpers.CursorPagination
 }
 
 func NewGetFeedListForm() *GetFeedList {
@@ -33,29 +29,24 @@ func NewGetFeedListForm() *GetFeedList {
 }
 
 func (f *GetFeedList) ParseAndValidate(r *http.Request) (form.Former, response.Error) {
-	var req *GetFeedRequest
-	err := json.NewDecoder(r.Body).Decode(&req)
-	if err != nil {
-		ve := response.NewValidationError()
-		ve.SetError(response.GeneralErrorKey, errs.InvalidRequestStructure, "invalid request structure")
+	var req GetFeedRequest
+	if err := form.BindJSON(r, &req); err != nil {
+		return nil, err
+	}
 
-		return nil, ve
+	if err := form.BindQuery(r, &f.CursorPagination); err != nil {
+		return nil, err
 	}
 
-	errors := make(map[string]response.ErrorMessage)
+	if err := f.ApplyCursor(); err != nil {
+		return nil, err
+	}
 
-	f.ValidateAndSetPagination(r, errors)
 	f.Types = req.Types
 	f.Actions = req.Actions
 	f.DaoList = req.DaoList
 	f.IsActive = req.IsActive
 
-	if len(errors) > 0 {
-		ve := response.NewValidationError(errors)
-
-		return nil, ve
-	}
-
 	return f, nil
 }
 
@@ -63,5 +54,6 @@ func (f *GetFeedList) ConvertToMap() map[string]interface{} {
 	return map[string]interface{}{
 		"offset": f.Offset,
 		"limit":  f.Limit,
+		"cursor": f.Cursor,
 	}
 }
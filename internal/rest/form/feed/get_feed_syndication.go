@@ -0,0 +1,64 @@
+package feed
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/goverland-labs/goverland-core-web-api/internal/response"
+	"github.com/goverland-labs/goverland-core-web-api/internal/rest/form"
+)
+
+// GetFeedSyndication has the filters of the feed list in the query, so the feed is able to be followed by URL.
+type GetFeedSyndication struct {
+	DaoList  []string `query:"dao_list" validate:"uuid" doc:"DAO identifiers"`
+	IsActive *bool    `query:"is_active" doc:"Only items of active proposals"`
+	Types    []string `query:"types" doc:"Item types"`
+	Actions  []string `query:"actions" doc:"Item actions"`
+	Limit    uint64   `query:"limit" default:"20" validate:"min=1,max=100" doc:"Max number of entries to return"`
+}
+
+func NewGetFeedSyndicationForm() *GetFeedSyndication {
+	return &GetFeedSyndication{}
+}
+
+func (f *GetFeedSyndication) ParseAndValidate(r *http.Request) (form.Former, response.Error) {
+	if err := form.BindQuery(r, f); err != nil {
+		return nil, err
+	}
+
+	return f, nil
+}
+
+// URN identifies the feed by the filters, it does not depend on the order of the values and the number of entries.
+func (f *GetFeedSyndication) URN() string {
+	filters := url.Values{}
+	for key, values := range map[string][]string{"dao_list": f.DaoList, "types": f.Types, "actions": f.Actions} {
+		for _, value := range values {
+			filters.Add(key, strings.ToLower(value))
+		}
+		slices.Sort(filters[key])
+		filters[key] = slices.Compact(filters[key])
+	}
+	if f.IsActive != nil {
+		filters.Set("is_active", strconv.FormatBool(*f.IsActive))
+	}
+
+	sum := sha256.Sum256([]byte(filters.Encode()))
+
+	return "urn:goverland:feed:" + hex.EncodeToString(sum[:16])
+}
+
+func (f *GetFeedSyndication) ConvertToMap() map[string]interface{} {
+	return map[string]interface{}{
+		"dao_list":  f.DaoList,
+		"is_active": f.IsActive,
+		"types":     f.Types,
+		"actions":   f.Actions,
+		"limit":     f.Limit,
+	}
+}
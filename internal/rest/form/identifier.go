@@ -0,0 +1,70 @@
+package form
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	ihelpers "github.com/goverland-labs/goverland-core-web-api/internal/helpers"
+	"github.com/goverland-labs/goverland-core-web-api/internal/response"
+	"github.com/goverland-labs/goverland-core-web-api/pkg/address"
+)
+
+// maxEnsNameLength is the max length of the DNS name which ENS names follow.
+const maxEnsNameLength = 255
+
+// ResolveIdentifier resolves the value of the field given as hex address or ENS name to the checksummed address.
+func ResolveIdentifier(ctx context.Context, resolver *ihelpers.IdentifierResolver, field, value string) (*ihelpers.ResolvedIdentifier, response.Error) {
+	resolved, err := resolver.Resolve(ctx, value)
+	if err == nil {
+		return resolved, nil
+	}
+
+	if errors.Is(err, ihelpers.ErrInvalidChecksum) {
+		return nil, response.NewValidationError(map[string]response.ErrorMessage{
+			field: response.WrongFormatError("should have the valid EIP-55 checksum"),
+		})
+	}
+
+	if status.Code(err) == codes.NotFound {
+		return nil, response.NewValidationError(map[string]response.ErrorMessage{
+			field: response.WrongValueError("ENS name is not registered"),
+		})
+	}
+
+	return nil, response.ResolveError(err)
+}
+
+// isIdentifier reports whether the value is a hex address or looks like an ENS name.
+func isIdentifier(value string) bool {
+	if address.IsHex(value) {
+		_, err := address.Parse(value)
+
+		return err == nil
+	}
+
+	return IsEnsName(value)
+}
+
+// IsEnsName reports whether the value looks like an ENS name, it does not check the name is registered.
+func IsEnsName(value string) bool {
+	if len(value) > maxEnsNameLength || strings.ContainsAny(value, " \t\r\n/\\@:") {
+		return false
+	}
+
+	labels := strings.Split(value, ".")
+	if len(labels) < 2 {
+		return false
+	}
+
+	for _, label := range labels {
+		if label == "" {
+			return false
+		}
+	}
+
+	return true
+}
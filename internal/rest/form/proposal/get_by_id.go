@@ -0,0 +1,36 @@
+package proposal
+
+import (
+	"net/http"
+
+	"github.com/goverland-labs/goverland-core-web-api/internal/response"
+	"github.com/goverland-labs/goverland-core-web-api/internal/rest/form"
+	helpers "github.com/goverland-labs/goverland-core-web-api/internal/rest/form/common"
+	model "github.com/goverland-labs/goverland-core-web-api/internal/rest/models/proposal"
+)
+
+type GetByID struct {
+	helpers.Fieldset
+}
+
+func NewGetByIDForm() *GetByID {
+	return &GetByID{}
+}
+
+func (f *GetByID) ParseAndValidate(r *http.Request) (form.Former, response.Error) {
+	if err := form.BindQuery(r, f); err != nil {
+		return nil, err
+	}
+
+	if err := f.CheckFields(model.Proposal{}); err != nil {
+		return nil, err
+	}
+
+	return f, nil
+}
+
+func (f *GetByID) ConvertToMap() map[string]interface{} {
+	return map[string]interface{}{
+		"fields": f.Fields,
+	}
+}
@@ -1,57 +1,174 @@
 package proposal
 
 import (
+	"fmt"
 	"net/http"
-	"strings"
+	"slices"
 
+	ihelpers "github.com/goverland-labs/goverland-core-web-api/internal/helpers"
 	"github.com/goverland-labs/goverland-core-web-api/internal/response"
 	"github.com/goverland-labs/goverland-core-web-api/internal/rest/form"
 	helpers "github.com/goverland-labs/goverland-core-web-api/internal/rest/form/common"
+	model "github.com/goverland-labs/goverland-core-web-api/internal/rest/models/proposal"
 )
 
+const (
+	SortNewest        = "newest"
+	SortEndingSoon    = "ending_soon"
+	SortMostVotes     = "most_votes"
+	SortHighestScores = "highest_scores"
+
+	// MaxScannedProposals is the number of the latest proposals matched by dao, category, title, proposals and
+	// only_active the API applies the other filters and the order to
+	MaxScannedProposals = 5000
+)
+
+// closedStates are the storage states of the proposals which voting is finished, the closed state matches all of them.
+var closedStates = []string{"closed", "succeeded", "failed", "defeated", "canceled"}
+
 type GetList struct {
 	helpers.Pagination
+	helpers.Fieldset
+	helpers.Include
+
+	Dao        string   `query:"dao" doc:"DAO identifier or alias"`
+	Category   string   `query:"category" doc:"DAO category"`
+	Title      string   `query:"title" doc:"Search by title"`
+	Proposals  []string `query:"proposals" doc:"Proposal identifiers"`
+	OnlyActive bool     `query:"only_active" doc:"Return only active proposals"`
 
-	Dao        string
-	Category   string
-	Title      string
-	Proposals  []string
-	OnlyActive bool
+	States      []string `query:"state" validate:"oneof=pending|active|closed" doc:"Proposal states, closed matches every finished proposal"`
+	Author      string   `query:"author" validate:"identifier" doc:"Author address or ENS name"`
+	Types       []string `query:"type" validate:"oneof=single-choice|approval|quadratic|ranked-choice|weighted|basic" doc:"Voting types"`
+	StartFrom   *uint64  `query:"start_from" doc:"Voting starts at or after the unix time"`
+	StartTo     *uint64  `query:"start_to" doc:"Voting starts at or before the unix time"`
+	EndFrom     *uint64  `query:"end_from" doc:"Voting ends at or after the unix time"`
+	EndTo       *uint64  `query:"end_to" doc:"Voting ends at or before the unix time"`
+	CreatedFrom *uint64  `query:"created_from" doc:"Created at or after the unix time"`
+	CreatedTo   *uint64  `query:"created_to" doc:"Created at or before the unix time"`
+	ExcludeSpam bool     `query:"exclude_spam" doc:"Skip proposals marked as spam"`
+	MinVotes    uint64   `query:"min_votes" doc:"Min number of votes"`
+	Sort        string   `query:"sort" default:"newest" validate:"oneof=newest|ending_soon|most_votes|highest_scores" doc:"Order of proposals, ending_soon puts the finished proposals last. The filters from state to min_votes and the orders other than newest require dao or proposals unless only the id, title, state and created fields are filtered and returned, they are applied to the latest 5000 proposals matched by the other filters and X-Total-Truncated is set when more proposals match"`
+
+	resolver *ihelpers.IdentifierResolver
 }
 
-func NewGetListForm() *GetList {
-	return &GetList{}
+func NewGetListForm(resolver *ihelpers.IdentifierResolver) *GetList {
+	return &GetList{resolver: resolver}
 }
 
 func (f *GetList) ParseAndValidate(r *http.Request) (form.Former, response.Error) {
-	errors := make(map[string]response.ErrorMessage)
+	if err := form.BindQuery(r, f); err != nil {
+		return nil, err
+	}
 
-	f.Dao = r.FormValue("dao")
-	f.Category = r.FormValue("category")
-	f.Title = r.FormValue("title")
-	f.OnlyActive = r.FormValue("only_active") == "true"
-	idsString := strings.TrimSpace(r.FormValue("proposals"))
-	if idsString != "" {
-		ids := strings.Split(idsString, ",")
-		f.Proposals = ids
+	if err := f.validateRanges(); err != nil {
+		return nil, err
 	}
-	f.ValidateAndSetPagination(r, errors)
 
-	if len(errors) > 0 {
-		ve := response.NewValidationError(errors)
+	if f.Author != "" {
+		resolved, verr := form.ResolveIdentifier(r.Context(), f.resolver, "author", f.Author)
+		if verr != nil {
+			return nil, verr
+		}
 
-		return nil, ve
+		f.Author = resolved.Address
+	}
+
+	if err := f.CheckFields(model.Proposal{}); err != nil {
+		return nil, err
 	}
 
 	return f, nil
 }
 
+func (f *GetList) validateRanges() response.Error {
+	errors := make(map[string]response.ErrorMessage)
+	ranges := []struct {
+		from, to         *uint64
+		fromName, toName string
+	}{
+		{f.StartFrom, f.StartTo, "start_from", "start_to"},
+		{f.EndFrom, f.EndTo, "end_from", "end_to"},
+		{f.CreatedFrom, f.CreatedTo, "created_from", "created_to"},
+	}
+	for _, rng := range ranges {
+		if rng.from != nil && rng.to != nil && *rng.from > *rng.to {
+			errors[rng.toName] = response.WrongValueError(fmt.Sprintf("should not be less than %s", rng.fromName))
+		}
+	}
+
+	if len(errors) > 0 {
+		return response.NewValidationError(errors)
+	}
+
+	return nil
+}
+
+// OnlyActiveStates reports whether the state filter asks for the active proposals only, the storage filters them itself.
+func (f *GetList) OnlyActiveStates() bool {
+	for _, state := range f.States {
+		if state != "active" {
+			return false
+		}
+	}
+
+	return len(f.States) > 0
+}
+
+// MatchState reports whether the proposal in the storage state passes the state filter.
+func (f *GetList) MatchState(state string) bool {
+	for _, s := range f.States {
+		if s == state || (s == "closed" && slices.Contains(closedStates, state)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Scoped reports whether the list is limited to the proposals of the DAO or to the given ones, so the API filters
+// and orders are applied to the bounded number of proposals.
+func (f *GetList) Scoped() bool {
+	return f.Dao != "" || len(f.Proposals) > 0
+}
+
+// ShortInfoFiltered reports whether the filters and the order applied by the API need only the short proposal info.
+func (f *GetList) ShortInfoFiltered() bool {
+	return f.Author == "" &&
+		len(f.Types) == 0 &&
+		f.StartFrom == nil && f.StartTo == nil &&
+		f.EndFrom == nil && f.EndTo == nil &&
+		!f.ExcludeSpam &&
+		f.MinVotes == 0 &&
+		f.Sort == SortNewest
+}
+
+// PostFiltered reports whether the filters or the order are not supported by the storage and are applied by the API.
+func (f *GetList) PostFiltered() bool {
+	return (len(f.States) > 0 && !f.OnlyActiveStates()) ||
+		f.Author != "" ||
+		len(f.Types) > 0 ||
+		f.StartFrom != nil || f.StartTo != nil ||
+		f.EndFrom != nil || f.EndTo != nil ||
+		f.CreatedFrom != nil || f.CreatedTo != nil ||
+		f.ExcludeSpam ||
+		f.MinVotes > 0 ||
+		f.Sort != SortNewest
+}
+
 func (f *GetList) ConvertToMap() map[string]interface{} {
 	return map[string]interface{}{
-		"dao":      f.Dao,
-		"category": f.Category,
-		"title":    f.Title,
-		"offset":   f.Offset,
-		"limit":    f.Limit,
+		"dao":          f.Dao,
+		"category":     f.Category,
+		"title":        f.Title,
+		"state":        f.States,
+		"author":       f.Author,
+		"type":         f.Types,
+		"exclude_spam": f.ExcludeSpam,
+		"min_votes":    f.MinVotes,
+		"sort":         f.Sort,
+		"offset":       f.Offset,
+		"limit":        f.Limit,
 	}
 }
@@ -6,10 +6,13 @@ import (
 	"github.com/goverland-labs/goverland-core-web-api/internal/response"
 	"github.com/goverland-labs/goverland-core-web-api/internal/rest/form"
 	helpers "github.com/goverland-labs/goverland-core-web-api/internal/rest/form/common"
+	model "github.com/goverland-labs/goverland-core-web-api/internal/rest/models/proposal"
 )
 
 type GetTop struct {
 	helpers.Pagination
+	helpers.Fieldset
+	helpers.Include
 }
 
 func NewGetTopForm() *GetTop {
@@ -17,14 +20,12 @@ func NewGetTopForm() *GetTop {
 }
 
 func (f *GetTop) ParseAndValidate(r *http.Request) (form.Former, response.Error) {
-	errors := make(map[string]response.ErrorMessage)
-
-	f.ValidateAndSetPagination(r, errors)
-
-	if len(errors) > 0 {
-		ve := response.NewValidationError(errors)
+	if err := form.BindQuery(r, f); err != nil {
+		return nil, err
+	}
 
-		return nil, ve
+	if err := f.CheckFields(model.Proposal{}); err != nil {
+		return nil, err
 	}
 
 	return f, nil
@@ -3,34 +3,52 @@ package proposal
 import (
 	"net/http"
 
+	ihelpers "github.com/goverland-labs/goverland-core-web-api/internal/helpers"
 	"github.com/goverland-labs/goverland-core-web-api/internal/response"
 	"github.com/goverland-labs/goverland-core-web-api/internal/rest/form"
 	helpers "github.com/goverland-labs/goverland-core-web-api/internal/rest/form/common"
+	model "github.com/goverland-labs/goverland-core-web-api/internal/rest/models/proposal"
 )
 
 type GetVotes struct {
-	helpers.Pagination
+	helpers.CursorPagination
+	helpers.Fieldset
+	helpers.Include
 
-	Voter string
-	Query string
+	Voter string `query:"voter" validate:"identifier" doc:"Voter address or ENS name to put first"`
+	Query string `query:"query" doc:"Search by voter address or ens name"`
+
+	// Resolved is the voter identifier given in the request
+	Resolved *ihelpers.ResolvedIdentifier
+	resolver *ihelpers.IdentifierResolver
 }
 
-func NewGetVotesForm() *GetVotes {
-	return &GetVotes{}
+func NewGetVotesForm(resolver *ihelpers.IdentifierResolver) *GetVotes {
+	return &GetVotes{resolver: resolver}
 }
 
 func (f *GetVotes) ParseAndValidate(r *http.Request) (form.Former, response.Error) {
-	errors := make(map[string]response.ErrorMessage)
+	if err := form.BindQuery(r, f); err != nil {
+		return nil, err
+	}
 
-	f.ValidateAndSetPagination(r, errors)
+	if f.Voter != "" {
+		resolved, verr := form.ResolveIdentifier(r.Context(), f.resolver, "voter", f.Voter)
+		if verr != nil {
+			return nil, verr
+		}
 
-	if len(errors) > 0 {
-		ve := response.NewValidationError(errors)
+		f.Voter = resolved.Address
+		f.Resolved = resolved
+	}
+
+	if err := f.CheckFields(model.Vote{}); err != nil {
+		return nil, err
+	}
 
-		return nil, ve
+	if err := f.ApplyCursor(); err != nil {
+		return nil, err
 	}
-	f.Voter = r.FormValue("voter")
-	f.Query = r.FormValue("query")
 
 	return f, nil
 }
@@ -41,5 +59,6 @@ func (f *GetVotes) ConvertToMap() map[string]interface{} {
 		"query":  f.Query,
 		"offset": f.Offset,
 		"limit":  f.Limit,
+		"cursor": f.Cursor,
 	}
 }
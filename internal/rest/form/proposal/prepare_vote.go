@@ -4,15 +4,15 @@ import (
 	"encoding/json"
 	"net/http"
 
+	ihelpers "github.com/goverland-labs/goverland-core-web-api/internal/helpers"
 	"github.com/goverland-labs/goverland-core-web-api/internal/response"
-	"github.com/goverland-labs/goverland-core-web-api/internal/response/errs"
 	"github.com/goverland-labs/goverland-core-web-api/internal/rest/form"
 	"github.com/goverland-labs/goverland-core-web-api/internal/rest/form/common"
 )
 
 type PrepareVoteRequest struct {
-	Voter  string          `json:"voter"`
-	Choice json.RawMessage `json:"choice"`
+	Voter  string          `json:"voter" validate:"required,identifier"`
+	Choice json.RawMessage `json:"choice" validate:"required,json"`
 	Reason *string         `json:"reason,omitempty"`
 }
 
@@ -20,34 +20,32 @@ type PrepareVote struct {
 	Voter  common.Voter  `json:"voter"`
 	Choice common.Choice `json:"choice"`
 	Reason *string       `json:"reason,omitempty"`
+
+	// Resolved is the voter identifier given in the request
+	Resolved *ihelpers.ResolvedIdentifier `json:"-"`
+	resolver *ihelpers.IdentifierResolver
 }
 
-func NewPrepareVoteForm() *PrepareVote {
-	return &PrepareVote{}
+func NewPrepareVoteForm(resolver *ihelpers.IdentifierResolver) *PrepareVote {
+	return &PrepareVote{resolver: resolver}
 }
 
 func (f *PrepareVote) ParseAndValidate(r *http.Request) (form.Former, response.Error) {
-	var req *PrepareVoteRequest
-	err := json.NewDecoder(r.Body).Decode(&req)
-	if err != nil {
-		ve := response.NewValidationError()
-		ve.SetError(response.GeneralErrorKey, errs.InvalidRequestStructure, "invalid request structure")
-
-		return nil, ve
+	var req PrepareVoteRequest
+	if err := form.BindJSON(r, &req); err != nil {
+		return nil, err
 	}
 
-	errors := make(map[string]response.ErrorMessage)
+	resolved, verr := form.ResolveIdentifier(r.Context(), f.resolver, "voter", req.Voter)
+	if verr != nil {
+		return nil, verr
+	}
 
-	f.Voter.ValidateAndSet(req.Voter, errors)
-	f.Choice.ValidateAndSet(req.Choice, errors)
+	f.Voter = common.Voter(resolved.Address)
+	f.Resolved = resolved
+	f.Choice = common.Choice(req.Choice)
 	f.Reason = req.Reason
 
-	if len(errors) > 0 {
-		ve := response.NewValidationError(errors)
-
-		return nil, ve
-	}
-
 	return f, nil
 }
 
@@ -0,0 +1,54 @@
+package proposal
+
+import (
+	"encoding/json"
+	"net/http"
+
+	ihelpers "github.com/goverland-labs/goverland-core-web-api/internal/helpers"
+	"github.com/goverland-labs/goverland-core-web-api/internal/response"
+	"github.com/goverland-labs/goverland-core-web-api/internal/rest/form"
+	"github.com/goverland-labs/goverland-core-web-api/internal/rest/form/common"
+)
+
+type SimulateVoteRequest struct {
+	Voter  string          `json:"voter" validate:"required,identifier"`
+	Choice json.RawMessage `json:"choice" validate:"required,json"`
+}
+
+type SimulateVote struct {
+	Voter  common.Voter  `json:"voter"`
+	Choice common.Choice `json:"choice"`
+
+	// Resolved is the voter identifier given in the request
+	Resolved *ihelpers.ResolvedIdentifier `json:"-"`
+	resolver *ihelpers.IdentifierResolver
+}
+
+func NewSimulateVoteForm(resolver *ihelpers.IdentifierResolver) *SimulateVote {
+	return &SimulateVote{resolver: resolver}
+}
+
+func (f *SimulateVote) ParseAndValidate(r *http.Request) (form.Former, response.Error) {
+	var req SimulateVoteRequest
+	if err := form.BindJSON(r, &req); err != nil {
+		return nil, err
+	}
+
+	resolved, verr := form.ResolveIdentifier(r.Context(), f.resolver, "voter", req.Voter)
+	if verr != nil {
+		return nil, verr
+	}
+
+	f.Voter = common.Voter(resolved.Address)
+	f.Resolved = resolved
+	f.Choice = common.Choice(req.Choice)
+
+	return f, nil
+}
+
+func (f *SimulateVote) ConvertToMap() map[string]interface{} {
+	return map[string]interface{}{
+		"voter":  f.Voter,
+		"choice": f.Choice,
+	}
+}
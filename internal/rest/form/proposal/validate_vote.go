@@ -1,47 +1,44 @@
 package proposal
 
 import (
-	"encoding/json"
 	"net/http"
 
+	ihelpers "github.com/goverland-labs/goverland-core-web-api/internal/helpers"
 	"github.com/goverland-labs/goverland-core-web-api/internal/response"
-	"github.com/goverland-labs/goverland-core-web-api/internal/response/errs"
 	"github.com/goverland-labs/goverland-core-web-api/internal/rest/form"
 	"github.com/goverland-labs/goverland-core-web-api/internal/rest/form/common"
 )
 
 type ValidateVoteRequest struct {
-	Voter string `json:"voter"`
+	Voter string `json:"voter" validate:"required,identifier"`
 }
 
 type ValidateVote struct {
 	Voter common.Voter `json:"voter"`
+
+	// Resolved is the voter identifier given in the request
+	Resolved *ihelpers.ResolvedIdentifier `json:"-"`
+	resolver *ihelpers.IdentifierResolver
 }
 
-func NewValidateVoteForm() *ValidateVote {
-	return &ValidateVote{}
+func NewValidateVoteForm(resolver *ihelpers.IdentifierResolver) *ValidateVote {
+	return &ValidateVote{resolver: resolver}
 }
 
 func (f *ValidateVote) ParseAndValidate(r *http.Request) (form.Former, response.Error) {
-	var req *ValidateVoteRequest
-	err := json.NewDecoder(r.Body).Decode(&req)
-	if err != nil {
-		ve := response.NewValidationError()
-		ve.SetError(response.GeneralErrorKey, errs.InvalidRequestStructure, "invalid request structure")
-
-		return nil, ve
+	var req ValidateVoteRequest
+	if err := form.BindJSON(r, &req); err != nil {
+		return nil, err
 	}
 
-	errors := make(map[string]response.ErrorMessage)
-
-	f.Voter.ValidateAndSet(req.Voter, errors)
-
-	if len(errors) > 0 {
-		ve := response.NewValidationError(errors)
-
-		return nil, ve
+	resolved, verr := form.ResolveIdentifier(r.Context(), f.resolver, "voter", req.Voter)
+	if verr != nil {
+		return nil, verr
 	}
 
+	f.Voter = common.Voter(resolved.Address)
+	f.Resolved = resolved
+
 	return f, nil
 }
 
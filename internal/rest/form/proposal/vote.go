@@ -1,17 +1,15 @@
 package proposal
 
 import (
-	"encoding/json"
 	"net/http"
 
 	"github.com/goverland-labs/goverland-core-web-api/internal/response"
-	"github.com/goverland-labs/goverland-core-web-api/internal/response/errs"
 	"github.com/goverland-labs/goverland-core-web-api/internal/rest/form"
 )
 
 type VoteRequest struct {
-	ID  string `json:"id"`
-	Sig string `json:"sig"`
+	ID  string `json:"id" validate:"required"`
+	Sig string `json:"sig" validate:"required"`
 }
 
 type Vote struct {
@@ -24,26 +22,14 @@ func NewVoteForm() *Vote {
 }
 
 func (f *Vote) ParseAndValidate(r *http.Request) (form.Former, response.Error) {
-	var req *VoteRequest
-	err := json.NewDecoder(r.Body).Decode(&req)
-	if err != nil {
-		ve := response.NewValidationError()
-		ve.SetError(response.GeneralErrorKey, errs.InvalidRequestStructure, "invalid request structure")
-
-		return nil, ve
+	var req VoteRequest
+	if err := form.BindJSON(r, &req); err != nil {
+		return nil, err
 	}
 
-	errors := make(map[string]response.ErrorMessage)
-
 	f.ID = req.ID
 	f.Sig = req.Sig
 
-	if len(errors) > 0 {
-		ve := response.NewValidationError(errors)
-
-		return nil, ve
-	}
-
 	return f, nil
 }
 
@@ -0,0 +1,161 @@
+package form
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/google/uuid"
+
+	"github.com/goverland-labs/goverland-core-web-api/internal/response"
+	"github.com/goverland-labs/goverland-core-web-api/internal/response/errs"
+	"github.com/goverland-labs/goverland-core-web-api/pkg/address"
+)
+
+const (
+	TagQuery    = "query"
+	TagValidate = "validate"
+	TagDefault  = "default"
+	TagDoc      = "doc"
+)
+
+// Rules is the parsed representation of the `validate` struct tag, e.g.
+// `validate:"required,oneof=delegation|split-delegation,min=1,max=100"`.
+//
+// Supported rules:
+//   - required: the value must be present
+//   - oneof=a|b: the value must be one of the listed values
+//   - uuid, address, url: the value must have the given format
+//   - identifier: the value must be an address or an ENS name
+//   - json: the value must be a valid JSON document
+//   - min=N, max=N: numeric bounds
+type Rules struct {
+	Required bool
+	OneOf    []string
+	Format   string
+	Min      *int64
+	Max      *int64
+}
+
+var rulesCache sync.Map
+
+// ParseRules parses the `validate` tag. It panics on malformed tags because they are programming errors.
+func ParseRules(tag string) Rules {
+	if cached, ok := rulesCache.Load(tag); ok {
+		return cached.(Rules)
+	}
+
+	var rules Rules
+	for _, rule := range strings.Split(tag, ",") {
+		name, arg, _ := strings.Cut(strings.TrimSpace(rule), "=")
+		switch name {
+		case "":
+		case "required":
+			rules.Required = true
+		case "oneof":
+			rules.OneOf = strings.Split(arg, "|")
+		case "uuid", "address", "identifier", "url", "json":
+			rules.Format = name
+		case "min", "max":
+			value, err := strconv.ParseInt(arg, 10, 64)
+			if err != nil {
+				panic(fmt.Sprintf("form: invalid %s rule in tag %q", name, tag))
+			}
+			if name == "min" {
+				rules.Min = &value
+			} else {
+				rules.Max = &value
+			}
+		default:
+			panic(fmt.Sprintf("form: unknown rule %q in tag %q", name, tag))
+		}
+	}
+
+	rulesCache.Store(tag, rules)
+
+	return rules
+}
+
+// checkString validates the string value against the rules.
+func (r Rules) checkString(value string) *response.ErrorMessage {
+	if len(r.OneOf) > 0 && !contains(r.OneOf, value) {
+		return &response.ErrorMessage{
+			Code:    errs.UnsupportedValue,
+			Message: fmt.Sprintf("should be one of: %s", strings.Join(r.OneOf, ", ")),
+		}
+	}
+
+	if r.Format == "address" || r.Format == "identifier" {
+		if _, err := address.Parse(value); errors.Is(err, address.ErrInvalidChecksum) {
+			msg := response.WrongFormatError("should have the valid EIP-55 checksum")
+
+			return &msg
+		}
+	}
+
+	if !r.matchFormat(value) {
+		format := r.Format
+		if format == "identifier" {
+			format = "address or ENS name"
+		}
+		msg := response.WrongFormatError(fmt.Sprintf("should be %s", format))
+
+		return &msg
+	}
+
+	return nil
+}
+
+func (r Rules) matchFormat(value string) bool {
+	switch r.Format {
+	case "uuid":
+		_, err := uuid.Parse(value)
+
+		return err == nil
+	case "address":
+		_, err := address.Parse(value)
+
+		return err == nil
+	case "identifier":
+		return isIdentifier(value)
+	case "url":
+		u, err := url.Parse(value)
+
+		return err == nil && (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+	case "json":
+		return json.Valid([]byte(value))
+	}
+
+	return true
+}
+
+// checkNumber validates the numeric value against the rules.
+func (r Rules) checkNumber(value int64) *response.ErrorMessage {
+	if r.Min != nil && value < *r.Min {
+		msg := response.WrongValueError(fmt.Sprintf("should be more or equal than %d", *r.Min))
+
+		return &msg
+	}
+
+	if r.Max != nil && value > *r.Max {
+		msg := response.WrongValueError(fmt.Sprintf("should be less or equal than %d", *r.Max))
+
+		return &msg
+	}
+
+	return nil
+}
+
+func contains(list []string, value string) bool {
+	for _, v := range list {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
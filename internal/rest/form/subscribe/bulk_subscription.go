@@ -0,0 +1,59 @@
+package subscribe
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/goverland-labs/goverland-core-web-api/internal/response"
+	"github.com/goverland-labs/goverland-core-web-api/internal/rest/form"
+)
+
+const maxBulkDaos = 100
+
+type BulkSubscriptionRequest struct {
+	DaoIDs []string `json:"daos" validate:"required,uuid" doc:"DAO identifiers, up to 100"`
+}
+
+type BulkSubscriptionForm struct {
+	DaoIDs []string
+}
+
+func NewBulkSubscriptionForm() *BulkSubscriptionForm {
+	return &BulkSubscriptionForm{}
+}
+
+func (f *BulkSubscriptionForm) ParseAndValidate(r *http.Request) (form.Former, response.Error) {
+	var req BulkSubscriptionRequest
+	if err := form.BindJSON(r, &req); err != nil {
+		return nil, err
+	}
+
+	switch {
+	case len(req.DaoIDs) == 0:
+		return nil, response.NewValidationError(map[string]response.ErrorMessage{
+			"daos": response.MissedValueError("daos is required"),
+		})
+	case len(req.DaoIDs) > maxBulkDaos:
+		return nil, response.NewValidationError(map[string]response.ErrorMessage{
+			"daos": response.WrongValueError(fmt.Sprintf("should contain less or equal than %d items", maxBulkDaos)),
+		})
+	}
+
+	// duplicates are reported once
+	seen := make(map[string]struct{}, len(req.DaoIDs))
+	for _, id := range req.DaoIDs {
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		f.DaoIDs = append(f.DaoIDs, id)
+	}
+
+	return f, nil
+}
+
+func (f *BulkSubscriptionForm) ConvertToMap() map[string]interface{} {
+	return map[string]interface{}{
+		"daos": f.DaoIDs,
+	}
+}
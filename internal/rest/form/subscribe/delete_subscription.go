@@ -1,16 +1,14 @@
 package subscribe
 
 import (
-	"encoding/json"
 	"net/http"
 
 	"github.com/goverland-labs/goverland-core-web-api/internal/response"
-	"github.com/goverland-labs/goverland-core-web-api/internal/response/errs"
 	"github.com/goverland-labs/goverland-core-web-api/internal/rest/form"
 )
 
 type UnsubscribeOnDaoRequest struct {
-	DaoID string `json:"dao"`
+	DaoID string `json:"dao" validate:"required,uuid"`
 }
 
 type UnsubscribeOnDaoForm struct {
@@ -22,13 +20,9 @@ func NewUnsubscribeOnDaoForm() *UnsubscribeOnDaoForm {
 }
 
 func (f *UnsubscribeOnDaoForm) ParseAndValidate(r *http.Request) (form.Former, response.Error) {
-	var req *UnsubscribeOnDaoRequest
-	err := json.NewDecoder(r.Body).Decode(&req)
-	if err != nil {
-		ve := response.NewValidationError()
-		ve.SetError(response.GeneralErrorKey, errs.InvalidRequestStructure, "invalid request structure")
-
-		return nil, ve
+	var req UnsubscribeOnDaoRequest
+	if err := form.BindJSON(r, &req); err != nil {
+		return nil, err
 	}
 
 	f.DaoID = req.DaoID
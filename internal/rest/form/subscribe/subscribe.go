@@ -1,34 +1,45 @@
 package subscribe
 
 import (
-	"encoding/json"
+	"errors"
 	"net/http"
 
+	"github.com/goverland-labs/goverland-core-web-api/internal/helpers"
 	"github.com/goverland-labs/goverland-core-web-api/internal/response"
-	"github.com/goverland-labs/goverland-core-web-api/internal/response/errs"
 	"github.com/goverland-labs/goverland-core-web-api/internal/rest/form"
 )
 
 type SubscribeRequest struct {
-	WebhookURL string `json:"webhook_url"`
+	WebhookURL string `json:"webhook_url" validate:"required,url"`
 }
 
 type SubscribeForm struct {
 	WebhookURL string
+
+	webhookURLs *helpers.WebhookURLValidator
 }
 
-func NewSubscribeForm() *SubscribeForm {
-	return &SubscribeForm{}
+func NewSubscribeForm(webhookURLs *helpers.WebhookURLValidator) *SubscribeForm {
+	return &SubscribeForm{
+		webhookURLs: webhookURLs,
+	}
 }
 
 func (f *SubscribeForm) ParseAndValidate(r *http.Request) (form.Former, response.Error) {
-	var req *SubscribeRequest
-	err := json.NewDecoder(r.Body).Decode(&req)
-	if err != nil {
-		ve := response.NewValidationError()
-		ve.SetError(response.GeneralErrorKey, errs.InvalidRequestStructure, "invalid request structure")
+	var req SubscribeRequest
+	if err := form.BindJSON(r, &req); err != nil {
+		return nil, err
+	}
+
+	if err := f.webhookURLs.Validate(r.Context(), req.WebhookURL); err != nil {
+		msg := response.WrongValueError(err.Error())
+		if errors.Is(err, helpers.ErrWebhookURLFormat) || errors.Is(err, helpers.ErrWebhookURLCredentials) {
+			msg = response.WrongFormatError(err.Error())
+		}
 
-		return nil, ve
+		return nil, response.NewValidationError(map[string]response.ErrorMessage{
+			"webhook_url": msg,
+		})
 	}
 
 	f.WebhookURL = req.WebhookURL
@@ -0,0 +1,48 @@
+package rest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/goverland-labs/goverland-core-web-api/internal/response"
+	instopb "github.com/goverland-labs/goverland-core-web-api/protocol/storage"
+)
+
+// GatewayPathPrefix is the prefix of all routes bound in protocol/*.proto via google.api.http options.
+const GatewayPathPrefix = "/gateway"
+
+// NewGateway serves the gRPC services generated from the web-api protobufs over HTTP/JSON.
+// Calls are dispatched in-process, so the gateway does not dial the gRPC listener.
+func NewGateway(ctx context.Context, dao instopb.DaoServer, proposal instopb.ProposalServer) (http.Handler, error) {
+	mux := runtime.NewServeMux(
+		runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONPb{
+			MarshalOptions: protojson.MarshalOptions{
+				UseProtoNames:   true,
+				EmitUnpopulated: true,
+			},
+			UnmarshalOptions: protojson.UnmarshalOptions{
+				DiscardUnknown: true,
+			},
+		}),
+		runtime.WithErrorHandler(handleGatewayError),
+	)
+
+	if err := instopb.RegisterDaoHandlerServer(ctx, mux, dao); err != nil {
+		return nil, fmt.Errorf("register dao gateway: %w", err)
+	}
+
+	if err := instopb.RegisterProposalHandlerServer(ctx, mux, proposal); err != nil {
+		return nil, fmt.Errorf("register proposal gateway: %w", err)
+	}
+
+	return mux, nil
+}
+
+// handleGatewayError keeps error bodies in the same shape as the hand-written routes.
+func handleGatewayError(_ context.Context, _ *runtime.ServeMux, _ runtime.Marshaler, w http.ResponseWriter, _ *http.Request, err error) {
+	response.HandleError(response.ResolveError(err), w)
+}
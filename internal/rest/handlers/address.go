@@ -0,0 +1,57 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/rs/zerolog/log"
+
+	ihelpers "github.com/goverland-labs/goverland-core-web-api/internal/helpers"
+	"github.com/goverland-labs/goverland-core-web-api/internal/response"
+	"github.com/goverland-labs/goverland-core-web-api/internal/rest/form"
+)
+
+type resolvedIdentifierKey struct{}
+
+// withResolvedAddress resolves the address path param given as hex address or ENS name before passing the request,
+// the handler takes the checksummed address by resolvedAddress. Invalid checksums and unregistered ENS names are
+// reported as validation errors of the address the same way as in forms.
+func withResolvedAddress(resolver *ihelpers.IdentifierResolver, next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		identifier := mux.Vars(r)["address"]
+		resolved, verr := form.ResolveIdentifier(r.Context(), resolver, "address", identifier)
+		if verr != nil {
+			log.Error().Str("identifier", identifier).Str("error", verr.PublicMessage()).Msg("resolve address identifier")
+			response.HandleError(verr, w)
+
+			return
+		}
+
+		addResolvedIdentifierHeaders(w, resolved)
+
+		next(w, r.WithContext(context.WithValue(r.Context(), resolvedIdentifierKey{}, resolved)))
+	}
+}
+
+// addResolvedIdentifierHeaders reports the address the ENS name of the request was resolved to.
+func addResolvedIdentifierHeaders(w http.ResponseWriter, resolved *ihelpers.ResolvedIdentifier) {
+	if resolved == nil {
+		return
+	}
+
+	response.AddResolvedIdentifierHeaders(w, resolved.Address, resolved.ENSName)
+}
+
+func resolvedIdentifier(r *http.Request) *ihelpers.ResolvedIdentifier {
+	resolved, _ := r.Context().Value(resolvedIdentifierKey{}).(*ihelpers.ResolvedIdentifier)
+	if resolved == nil {
+		return &ihelpers.ResolvedIdentifier{}
+	}
+
+	return resolved
+}
+
+func resolvedAddress(r *http.Request) string {
+	return resolvedIdentifier(r).Address
+}
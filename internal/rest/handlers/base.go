@@ -1,7 +1,46 @@
 package handlers
 
-import "github.com/gorilla/mux"
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/goverland-labs/goverland-core-web-api/internal/rest/openapi"
+)
 
 type APIHandler interface {
 	EnrichRoutes(v1, v2 *mux.Router)
+	// Describe returns the OpenAPI description of every route added in EnrichRoutes
+	Describe() []openapi.Route
 }
+
+// StreamHandler is implemented by the handlers streaming long responses, their routes are not wrapped by the handle
+// timeout as it buffers the whole response until the handler returns.
+type StreamHandler interface {
+	EnrichStreamRoutes(v1 *mux.Router)
+}
+
+// IdempotentHandler is implemented by the handlers with the mutating routes deduplicated by the Idempotency-Key
+// header, the keys are scoped by the route name and the client returned by its scope.
+type IdempotentHandler interface {
+	IdempotencyScopes() map[string]IdempotencyScope
+}
+
+// IdempotencyScope returns the client the request with the body belongs to, the request is not deduplicated when
+// the client is not known.
+type IdempotencyScope func(r *http.Request, body []byte) (string, bool)
+
+// idempotencyScopeDelim joins the parts of the idempotency scope.
+const idempotencyScopeDelim = "\x00"
+
+const (
+	tagDao        = "dao"
+	tagDelegates  = "delegates"
+	tagEns        = "ens"
+	tagFeed       = "feed"
+	tagProposals  = "proposals"
+	tagStats      = "stats"
+	tagSubscriber = "subscriber"
+	tagUser       = "user"
+	tagVotes      = "votes"
+)
@@ -0,0 +1,245 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"math"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/goverland-labs/goverland-core-storage/protocol/storagepb"
+	"github.com/rs/zerolog/log"
+
+	"github.com/goverland-labs/goverland-core-web-api/pkg/ical"
+)
+
+const (
+	calendarProdID = "-//Goverland//Core Web API//EN"
+	// calendarUIDDomain makes the event identifiers globally unique as RFC 5545 recommends
+	calendarUIDDomain = "goverland.xyz"
+	// calendarProposalsLimit is the max number of the active DAO proposals and of the latest ones checked for the
+	// upcoming ones
+	calendarProposalsLimit uint64 = 100
+	// calendarDaosConcurrency is the number of DAOs the proposals are loaded for at once
+	calendarDaosConcurrency = 8
+	// calendarMaxDaos is the max number of DAOs in one calendar, the rest of them are skipped
+	calendarMaxDaos = 50
+)
+
+// calendarStates are the states of the proposals which voting is not finished yet
+var calendarStates = map[string]bool{
+	"pending": true,
+	"active":  true,
+}
+
+func writeCalendar(w http.ResponseWriter, calendar ical.Calendar) {
+	w.Header().Set("Content-Type", ical.ContentType+"; charset=utf-8")
+	if err := ical.Write(w, calendar); err != nil {
+		log.Warn().Err(err).Str("calendar", calendar.Name).Msg("write calendar")
+	}
+}
+
+// loadCalendarProposals returns the active and upcoming proposals of the DAO. The storage filters the active ones
+// itself, the upcoming ones are found by the short info of the latest proposals and only they are loaded in full.
+func loadCalendarProposals(ctx context.Context, pc storagepb.ProposalClient, daoID string) ([]*storagepb.ProposalInfo, error) {
+	limit, onlyActive := calendarProposalsLimit, true
+	active, err := pc.GetByFilter(ctx, &storagepb.ProposalByFilterRequest{
+		Dao:        &daoID,
+		Limit:      &limit,
+		OnlyActive: &onlyActive,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	latest, err := pc.GetByFilter(ctx, &storagepb.ProposalByFilterRequest{
+		Dao:   &daoID,
+		Limit: &limit,
+		Level: storagepb.ProposalInfoLevel_PROPOSAL_INFO_LEVEL_SHORT.Enum(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]*storagepb.ProposalInfo, 0, len(active.GetProposals()))
+	seen := make(map[string]bool, len(active.GetProposals()))
+	for _, info := range active.GetProposals() {
+		if calendarStates[info.GetState()] {
+			list = append(list, info)
+			seen[info.GetId()] = true
+		}
+	}
+
+	var upcoming []string
+	for _, info := range latest.GetProposalsShort() {
+		if calendarStates[info.GetState()] && !seen[info.GetId()] {
+			upcoming = append(upcoming, info.GetId())
+		}
+	}
+	if len(upcoming) == 0 {
+		return list, nil
+	}
+
+	count := uint64(len(upcoming))
+	resp, err := pc.GetByFilter(ctx, &storagepb.ProposalByFilterRequest{
+		ProposalIds: upcoming,
+		Limit:       &count,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, info := range resp.GetProposals() {
+		if calendarStates[info.GetState()] && !seen[info.GetId()] {
+			list = append(list, info)
+			seen[info.GetId()] = true
+		}
+	}
+
+	return list, nil
+}
+
+// limitCalendarDaos keeps the first calendarMaxDaos of the DAOs, so a calendar makes a bounded number of requests.
+func limitCalendarDaos(daoIDs []string) []string {
+	if len(daoIDs) <= calendarMaxDaos {
+		return daoIDs
+	}
+
+	log.Warn().Int("daos", len(daoIDs)).Int("limit", calendarMaxDaos).Msg("calendar daos are limited")
+
+	return daoIDs[:calendarMaxDaos]
+}
+
+// loadDaosCalendarProposals loads the active and upcoming proposals of at most calendarMaxDaos DAOs, the first error
+// fails the whole calendar, so the clients keep the previous version instead of removing the events of the failed DAO.
+func loadDaosCalendarProposals(ctx context.Context, pc storagepb.ProposalClient, daoIDs []string) ([]*storagepb.ProposalInfo, error) {
+	daoIDs = limitCalendarDaos(daoIDs)
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		list     []*storagepb.ProposalInfo
+		firstErr error
+		mu       sync.Mutex
+		wg       sync.WaitGroup
+	)
+	sem := make(chan struct{}, calendarDaosConcurrency)
+	for _, id := range daoIDs {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(id string) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			proposals, err := loadCalendarProposals(ctx, pc, id)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				if firstErr == nil {
+					firstErr = err
+					cancel()
+				}
+
+				return
+			}
+			list = append(list, proposals...)
+		}(id)
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+
+	return list, nil
+}
+
+// newProposalsCalendar builds the calendar with the events ordered by the end of the voting.
+func newProposalsCalendar(name string, proposals []*storagepb.ProposalInfo, daoNames map[string]string) ical.Calendar {
+	events := make([]ical.Event, len(proposals))
+	for i, info := range proposals {
+		events[i] = convertToCalendarEventFromProto(info, daoNames[info.GetDaoId()])
+	}
+	sort.Slice(events, func(i, j int) bool {
+		if !events[i].End.Equal(events[j].End) {
+			return events[i].End.Before(events[j].End)
+		}
+
+		return events[i].UID < events[j].UID
+	})
+
+	return ical.Calendar{
+		ProdID: calendarProdID,
+		Name:   name,
+		Events: events,
+	}
+}
+
+// convertToCalendarEventFromProto covers the voting window of the proposal, the UID depends on the proposal only,
+// so the clients update the event in place when the proposal is changed.
+func convertToCalendarEventFromProto(info *storagepb.ProposalInfo, daoName string) ical.Event {
+	summary := info.GetTitle()
+	if daoName != "" {
+		summary = daoName + ": " + summary
+	}
+
+	created := info.GetCreatedAt().AsTime()
+	stamp := info.GetUpdatedAt().AsTime()
+	if info.GetUpdatedAt() == nil || stamp.Before(created) {
+		stamp = created
+	}
+
+	var description string
+	if info.GetLink() != "" {
+		description = fmt.Sprintf("Vote: %s", info.GetLink())
+	}
+
+	return ical.Event{
+		UID:         info.GetId() + "@" + calendarUIDDomain,
+		Summary:     summary,
+		Description: description,
+		URL:         info.GetLink(),
+		Start:       time.Unix(int64(info.GetStart()), 0),
+		End:         time.Unix(int64(info.GetEnd()), 0),
+		Stamp:       stamp,
+		Sequence:    calendarSequence(info),
+	}
+}
+
+// calendarSequence is the hash of the voting window and the title of the proposal, it changes only with the fields
+// of the event, so updates of the votes or the scores do not make the clients reload the event.
+func calendarSequence(info *storagepb.ProposalInfo) int64 {
+	h := fnv.New32a()
+	_, _ = fmt.Fprintf(h, "%d\x00%d\x00%s", info.GetStart(), info.GetEnd(), info.GetTitle())
+
+	return int64(h.Sum32() & math.MaxInt32)
+}
+
+// calendarDaoNames returns the names of the DAOs by identifiers.
+func calendarDaoNames(ctx context.Context, dc storagepb.DaoClient, ids []string) (map[string]string, error) {
+	parsed := make([]uuid.UUID, 0, len(ids))
+	for _, id := range ids {
+		if value, err := uuid.Parse(id); err == nil {
+			parsed = append(parsed, value)
+		}
+	}
+
+	daos, err := loadCompactDaos(ctx, dc, parsed)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make(map[string]string, len(daos))
+	for id, compact := range daos {
+		names[id.String()] = compact.Name
+	}
+
+	return names, nil
+}
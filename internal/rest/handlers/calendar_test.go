@@ -0,0 +1,107 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/goverland-labs/goverland-core-storage/protocol/storagepb"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type calendarProposalClientMock struct {
+	storagepb.ProposalClient
+
+	requests []*storagepb.ProposalByFilterRequest
+}
+
+func (m *calendarProposalClientMock) GetByFilter(_ context.Context, in *storagepb.ProposalByFilterRequest, _ ...grpc.CallOption) (*storagepb.ProposalByFilterResponse, error) {
+	m.requests = append(m.requests, in)
+	switch {
+	case in.GetOnlyActive():
+		return &storagepb.ProposalByFilterResponse{Proposals: []*storagepb.ProposalInfo{{Id: "active", State: "active"}}}, nil
+	case in.GetLevel() == storagepb.ProposalInfoLevel_PROPOSAL_INFO_LEVEL_SHORT:
+		return &storagepb.ProposalByFilterResponse{ProposalsShort: []*storagepb.ProposalShortInfo{
+			{Id: "pending", State: "pending"},
+			{Id: "active", State: "active"},
+			{Id: "closed", State: "closed"},
+		}}, nil
+	}
+
+	list := make([]*storagepb.ProposalInfo, len(in.GetProposalIds()))
+	for i, id := range in.GetProposalIds() {
+		list[i] = &storagepb.ProposalInfo{Id: id, State: id}
+	}
+
+	return &storagepb.ProposalByFilterResponse{Proposals: list}, nil
+}
+
+func TestLoadCalendarProposals(t *testing.T) {
+	pc := &calendarProposalClientMock{}
+	list, err := loadCalendarProposals(context.Background(), pc, "dao")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(list) != 2 || list[0].GetId() != "active" || list[1].GetId() != "pending" {
+		t.Errorf("proposals = %v, want active and pending", list)
+	}
+	if len(pc.requests) != 3 {
+		t.Fatalf("got %d requests, want 3", len(pc.requests))
+	}
+	if ids := pc.requests[2].GetProposalIds(); len(ids) != 1 || ids[0] != "pending" {
+		t.Errorf("loaded in full %v, want only the pending proposal", ids)
+	}
+}
+
+func TestConvertToCalendarEventFromProto_Sequence(t *testing.T) {
+	created := time.Date(2024, 2, 1, 12, 0, 0, 0, time.UTC)
+	info := &storagepb.ProposalInfo{
+		Id:        "0x1",
+		Title:     "Proposal",
+		Start:     1706788800,
+		End:       1707048000,
+		CreatedAt: timestamppb.New(created),
+	}
+	sequence := convertToCalendarEventFromProto(info, "").Sequence
+	if sequence < 0 {
+		t.Fatalf("Sequence = %d, want non-negative", sequence)
+	}
+
+	updated := proto.Clone(info).(*storagepb.ProposalInfo)
+	updated.UpdatedAt = timestamppb.New(created.Add(time.Hour))
+	updated.Votes = 10
+	if got := convertToCalendarEventFromProto(updated, "DAO").Sequence; got != sequence {
+		t.Errorf("Sequence of the proposal with new votes = %d, want %d", got, sequence)
+	}
+
+	for name, change := range map[string]func(*storagepb.ProposalInfo){
+		"start": func(p *storagepb.ProposalInfo) { p.Start++ },
+		"end":   func(p *storagepb.ProposalInfo) { p.End++ },
+		"title": func(p *storagepb.ProposalInfo) { p.Title += "!" },
+	} {
+		changed := proto.Clone(info).(*storagepb.ProposalInfo)
+		change(changed)
+		if got := convertToCalendarEventFromProto(changed, "").Sequence; got == sequence {
+			t.Errorf("Sequence is not changed by the %s", name)
+		}
+	}
+}
+
+func TestLimitCalendarDaos(t *testing.T) {
+	ids := make([]string, calendarMaxDaos+10)
+	for i := range ids {
+		ids[i] = fmt.Sprintf("dao-%d", i)
+	}
+
+	if got := limitCalendarDaos(ids[:3]); len(got) != 3 {
+		t.Errorf("limited %d daos, want 3", len(got))
+	}
+	got := limitCalendarDaos(ids)
+	if len(got) != calendarMaxDaos || got[0] != "dao-0" {
+		t.Errorf("limited to %d daos starting with %s, want %d starting with dao-0", len(got), got[0], calendarMaxDaos)
+	}
+}
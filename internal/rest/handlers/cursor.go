@@ -0,0 +1,125 @@
+package handlers
+
+import (
+	"github.com/goverland-labs/goverland-core-feed/protocol/feedpb"
+	"github.com/goverland-labs/goverland-core-storage/protocol/storagepb"
+
+	"github.com/goverland-labs/goverland-core-web-api/internal/rest/form/common"
+)
+
+// cursorScanWindows is the max number of storage windows scanned for the position of the cursor
+const cursorScanWindows = 10
+
+// cursorFetch loads the window of the list by offset and limit, it returns the items and the total count.
+type cursorFetch[T any] func(offset, limit uint64) ([]T, uint64, error)
+
+// cursorList returns the page of the list, the offset of its first item, the total count and the cursor of
+// the next page, empty when there are no more items. Cursor requests continue right after the item of the cursor
+// wherever it is now, so rows inserted or removed since the previous page neither repeat nor skip the items.
+func cursorList[T any](p common.CursorPagination, fetch cursorFetch[T], key func(T) common.CursorKey) ([]T, uint64, uint64, string, error) {
+	offset := p.Offset
+
+	var (
+		items []T
+		total uint64
+		err   error
+	)
+	if p.After.ID != "" {
+		var found bool
+		offset, items, total, found, err = locateCursor(p, fetch, key)
+		if err != nil {
+			return nil, 0, 0, "", err
+		}
+		if !found || (uint64(len(items)) < p.Limit && offset+uint64(len(items)) < total) {
+			items = nil
+		}
+	}
+	if items == nil {
+		if items, total, err = fetch(offset, p.Limit); err != nil {
+			return nil, 0, 0, "", err
+		}
+	}
+	if uint64(len(items)) > p.Limit {
+		items = items[:p.Limit]
+	}
+
+	next := offset + uint64(len(items))
+	if len(items) == 0 || next >= total {
+		return items, offset, total, "", nil
+	}
+
+	return items, offset, total, common.Cursor{Offset: next, CursorKey: key(items[len(items)-1])}.Encode(), nil
+}
+
+// locateCursor scans the storage from the position hint of the cursor and returns the position right after its
+// item with the items of the scanned window from there. The window starts a page before the hint, it moves forward
+// while all its items are newer than the item of the cursor and backward while they are older, the lists are
+// ordered by the creation time descending and by the identifier for the same time. When the item was removed,
+// the position is the first older item. The position is not found for the lists not ordered by the creation time
+// when the item is not in the first window, and for the cursors moved further than cursorScanWindows windows, they
+// continue from the hint.
+func locateCursor[T any](p common.CursorPagination, fetch cursorFetch[T], key func(T) common.CursorKey) (uint64, []T, uint64, bool, error) {
+	size := 2*p.Limit + 1
+	start := p.Offset - min(p.Offset, p.Limit+1)
+
+	var total uint64
+	for range cursorScanWindows {
+		items, count, err := fetch(start, size)
+		if err != nil {
+			return 0, nil, 0, false, err
+		}
+		total = count
+
+		older := len(items)
+		for i, item := range items {
+			k := key(item)
+			if k.ID == p.After.ID {
+				return start + uint64(i) + 1, items[i+1:], total, true, nil
+			}
+			if older == len(items) && isOlderCursorKey(k, p.After) {
+				older = i
+			}
+		}
+
+		switch {
+		case p.After.Created == 0:
+			return p.Offset, nil, total, false, nil
+		case older == 0 && start > 0:
+			start -= min(start, size)
+		case older == len(items) && start+uint64(len(items)) < total:
+			start += uint64(len(items))
+		default:
+			return start + uint64(older), items[older:], total, true, nil
+		}
+	}
+
+	return p.Offset, nil, total, false, nil
+}
+
+func isOlderCursorKey(k, than common.CursorKey) bool {
+	if k.Created != than.Created {
+		return k.Created < than.Created
+	}
+
+	return k.ID < than.ID
+}
+
+// userVoteCursorKey keys the votes of the voter ordered by the creation time.
+func userVoteCursorKey(info *storagepb.VoteInfo) common.CursorKey {
+	return common.CursorKey{Created: int64(info.GetCreated()), ID: info.GetId()}
+}
+
+// proposalVoteCursorKey keys the proposal votes by the identifier only, they are not ordered by the creation time
+// since the requested voter is put first.
+func proposalVoteCursorKey(info *storagepb.VoteInfo) common.CursorKey {
+	return common.CursorKey{ID: info.GetId()}
+}
+
+// delegatorCursorKey keys the delegators by the address only, they have no creation time.
+func delegatorCursorKey(info *storagepb.DelegatorEntry) common.CursorKey {
+	return common.CursorKey{ID: info.GetAddress()}
+}
+
+func feedCursorKey(info *feedpb.FeedInfo) common.CursorKey {
+	return common.CursorKey{Created: info.GetCreatedAt().AsTime().Unix(), ID: info.GetId()}
+}
@@ -0,0 +1,198 @@
+package handlers
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/goverland-labs/goverland-core-web-api/internal/rest/form/common"
+)
+
+type cursorRow struct {
+	created int64
+	id      string
+}
+
+func cursorRowKey(r cursorRow) common.CursorKey {
+	return common.CursorKey{Created: r.created, ID: r.id}
+}
+
+// cursorRows returns the rows ordered by the creation time descending, the row "0" is the newest one.
+func cursorRows(total int) []cursorRow {
+	list := make([]cursorRow, total)
+	for i := range list {
+		list[i] = cursorRow{created: int64(1000 - i), id: strconv.Itoa(i)}
+	}
+
+	return list
+}
+
+// insertCursorRows prepends the rows created after the existing ones.
+func insertCursorRows(list []cursorRow, count int) []cursorRow {
+	inserted := make([]cursorRow, 0, count+len(list))
+	for i := count; i > 0; i-- {
+		inserted = append(inserted, cursorRow{created: int64(2000 + i), id: "new" + strconv.Itoa(i)})
+	}
+
+	return append(inserted, list...)
+}
+
+func removeCursorRows(list []cursorRow, ids ...string) []cursorRow {
+	removed := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		removed[id] = true
+	}
+
+	kept := make([]cursorRow, 0, len(list))
+	for _, r := range list {
+		if !removed[r.id] {
+			kept = append(kept, r)
+		}
+	}
+
+	return kept
+}
+
+func fetchCursorRows(list []cursorRow) cursorFetch[cursorRow] {
+	return func(offset, limit uint64) ([]cursorRow, uint64, error) {
+		start := min(int(offset), len(list))
+		end := min(start+int(limit), len(list))
+
+		return list[start:end], uint64(len(list)), nil
+	}
+}
+
+func ids(list []cursorRow) []string {
+	result := make([]string, len(list))
+	for i, r := range list {
+		result[i] = r.id
+	}
+
+	return result
+}
+
+func TestCursorList(t *testing.T) {
+	after := func(offset uint64, id string) common.CursorPagination {
+		i, _ := strconv.Atoi(id)
+
+		return common.CursorPagination{
+			Pagination: common.Pagination{Offset: offset, Limit: 3},
+			After:      common.CursorKey{Created: int64(1000 - i), ID: id},
+		}
+	}
+	cursorAt := func(offset uint64, id string) string {
+		i, _ := strconv.Atoi(id)
+
+		return common.Cursor{Offset: offset, CursorKey: common.CursorKey{Created: int64(1000 - i), ID: id}}.Encode()
+	}
+
+	for name, tc := range map[string]struct {
+		page common.CursorPagination
+		list []cursorRow
+
+		items  []string
+		offset uint64
+		next   string
+	}{
+		"offset request": {
+			page:   common.CursorPagination{Pagination: common.Pagination{Offset: 2, Limit: 3}},
+			list:   cursorRows(10),
+			items:  []string{"2", "3", "4"},
+			offset: 2,
+			next:   cursorAt(5, "4"),
+		},
+		"last page": {
+			page:   common.CursorPagination{Pagination: common.Pagination{Offset: 8, Limit: 3}},
+			list:   cursorRows(10),
+			items:  []string{"8", "9"},
+			offset: 8,
+		},
+		"cursor without changes": {
+			page:   after(3, "2"),
+			list:   cursorRows(10),
+			items:  []string{"3", "4", "5"},
+			offset: 3,
+			next:   cursorAt(6, "5"),
+		},
+		"cursor with new rows": {
+			page:   after(3, "2"),
+			list:   insertCursorRows(cursorRows(10), 2),
+			items:  []string{"3", "4", "5"},
+			offset: 5,
+			next:   cursorAt(8, "5"),
+		},
+		"more new rows than the window": {
+			page:   after(3, "2"),
+			list:   insertCursorRows(cursorRows(10), 25),
+			items:  []string{"3", "4", "5"},
+			offset: 28,
+			next:   cursorAt(31, "5"),
+		},
+		"rows removed in front of the cursor": {
+			page:   after(15, "14"),
+			list:   removeCursorRows(cursorRows(20), "0", "1", "2", "3", "4", "5", "6", "7", "8", "9"),
+			items:  []string{"15", "16", "17"},
+			offset: 5,
+			next:   cursorAt(8, "17"),
+		},
+		"item of the cursor is removed": {
+			page:   after(3, "2"),
+			list:   removeCursorRows(cursorRows(10), "2"),
+			items:  []string{"3", "4", "5"},
+			offset: 2,
+			next:   cursorAt(5, "5"),
+		},
+		"item of the cursor was the last one": {
+			page:   after(10, "9"),
+			list:   insertCursorRows(cursorRows(10), 1),
+			items:  []string{},
+			offset: 11,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			items, offset, total, next, err := cursorList(tc.page, fetchCursorRows(tc.list), cursorRowKey)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := ids(items); !reflect.DeepEqual(got, tc.items) {
+				t.Errorf("expected items %v, got %v", tc.items, got)
+			}
+			if offset != tc.offset {
+				t.Errorf("expected offset %d, got %d", tc.offset, offset)
+			}
+			if total != uint64(len(tc.list)) {
+				t.Errorf("expected total %d, got %d", len(tc.list), total)
+			}
+			if next != tc.next {
+				t.Errorf("expected next cursor %q, got %q", tc.next, next)
+			}
+		})
+	}
+}
+
+func TestCursorList_NotOrderedByTime(t *testing.T) {
+	page := common.CursorPagination{
+		Pagination: common.Pagination{Offset: 3, Limit: 3},
+		After:      common.CursorKey{ID: "2"},
+	}
+	key := func(r cursorRow) common.CursorKey {
+		return common.CursorKey{ID: r.id}
+	}
+
+	items, offset, _, _, err := cursorList(page, fetchCursorRows(insertCursorRows(cursorRows(10), 2)), key)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := ids(items); !reflect.DeepEqual(got, []string{"3", "4", "5"}) || offset != 5 {
+		t.Errorf("expected items after the cursor at 5, got %v at %d", got, offset)
+	}
+
+	// the item is not found without the creation time, the page continues from the hint
+	items, offset, _, _, err = cursorList(page, fetchCursorRows(insertCursorRows(cursorRows(10), 20)), key)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := ids(items); !reflect.DeepEqual(got, []string{"new17", "new16", "new15"}) || offset != 3 {
+		t.Errorf("expected items from the hint, got %v at %d", got, offset)
+	}
+}
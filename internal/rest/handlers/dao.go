@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"time"
 
@@ -12,10 +13,18 @@ import (
 	"github.com/rs/zerolog/log"
 	"go.openly.dev/pointy"
 
+	ihelpers "github.com/goverland-labs/goverland-core-web-api/internal/helpers"
 	"github.com/goverland-labs/goverland-core-web-api/internal/response"
+	"github.com/goverland-labs/goverland-core-web-api/internal/rest/fieldset"
 	forms "github.com/goverland-labs/goverland-core-web-api/internal/rest/form/dao"
 	"github.com/goverland-labs/goverland-core-web-api/internal/rest/models/dao"
+	"github.com/goverland-labs/goverland-core-web-api/internal/rest/models/delegate"
+	"github.com/goverland-labs/goverland-core-web-api/internal/rest/models/overview"
+	"github.com/goverland-labs/goverland-core-web-api/internal/rest/openapi"
+	"github.com/goverland-labs/goverland-core-web-api/pkg/address"
 	"github.com/goverland-labs/goverland-core-web-api/pkg/helpers"
+	"github.com/goverland-labs/goverland-core-web-api/pkg/ical"
+	"github.com/goverland-labs/goverland-core-web-api/pkg/syndication"
 )
 
 const (
@@ -26,15 +35,23 @@ const (
 
 type DAO struct {
 	dc             storagepb.DaoClient
+	pc             storagepb.ProposalClient
 	fc             feedpb.FeedClient
 	delegateClient storagepb.DelegateClient
+	resolver       *ihelpers.IdentifierResolver
+	ens            *ihelpers.EnsEnricher
+	publicBaseURL  string
 }
 
-func NewDaoHandler(dc storagepb.DaoClient, fc feedpb.FeedClient, delegateClient storagepb.DelegateClient) APIHandler {
+func NewDaoHandler(dc storagepb.DaoClient, pc storagepb.ProposalClient, fc feedpb.FeedClient, delegateClient storagepb.DelegateClient, resolver *ihelpers.IdentifierResolver, ens *ihelpers.EnsEnricher, publicBaseURL string) APIHandler {
 	return &DAO{
 		dc:             dc,
+		pc:             pc,
 		fc:             fc,
 		delegateClient: delegateClient,
+		resolver:       resolver,
+		ens:            ens,
+		publicBaseURL:  publicBaseURL,
 	}
 }
 
@@ -42,25 +59,208 @@ func (h *DAO) EnrichRoutes(v1, v2 *mux.Router) {
 	v1.HandleFunc("/daos/top", h.getTopAction).Methods(http.MethodGet).Name("get_dao_top")
 	v1.HandleFunc("/daos/recommendations", h.getRecommendations).Methods(http.MethodGet).Name("get_dao_recommendations")
 	v1.HandleFunc("/daos/{id}/feed", h.getFeedByIDAction).Methods(http.MethodGet).Name("get_dao_feed_by_id")
+	v1.HandleFunc("/daos/{id}/feed.atom", h.getSyndicationFeedAction(atomFormat)).Methods(http.MethodGet).Name("get_dao_feed_atom")
+	v1.HandleFunc("/daos/{id}/feed.rss", h.getSyndicationFeedAction(rssFormat)).Methods(http.MethodGet).Name("get_dao_feed_rss")
+	v1.HandleFunc("/daos/{id}/proposals.ics", h.getCalendarAction).Methods(http.MethodGet).Name("get_dao_calendar")
+	v1.HandleFunc("/daos/{id}/overview", h.getOverviewAction).Methods(http.MethodGet).Name("get_dao_overview")
 	v1.HandleFunc("/daos/{id}", h.getByIDAction).Methods(http.MethodGet).Name("get_dao_by_id")
 	v1.HandleFunc("/daos", h.getListAction).Methods(http.MethodGet).Name("get_dao_list")
 	v1.HandleFunc("/daos/{id}/delegates", h.getDelegates).Methods(http.MethodGet).Name("get_delegates_list")
 	v1.HandleFunc("/daos/{id}/delegate-profile", h.getDelegateProfile).Methods(http.MethodGet).Name("get_delegate_profile")
-	v1.HandleFunc("/daos/{id}/delegates/{address}/delegators", h.getDelegators).Methods(http.MethodGet).Name("get_delegators")
+	v1.HandleFunc("/daos/{id}/delegates/{address}/delegators", withResolvedAddress(h.resolver, h.getDelegators)).Methods(http.MethodGet).Name("get_delegators")
 	v1.HandleFunc("/daos/{id}/token-info", h.getTokenInfo).Methods(http.MethodGet).Name("get_dao_token_info")
 	v1.HandleFunc("/daos/{id}/token-chart", h.getTokenChart).Methods(http.MethodGet).Name("get_dao_token_chart")
 	v1.HandleFunc("/daos/{id}/populate-token-price", h.populateTokenPrice).Methods(http.MethodPost).Name("populate_dao_token_price")
 	v1.HandleFunc("/daos/update-fungible-ids", h.updateFungibleIds).Methods(http.MethodPost).Name("update_fungible_ids")
 
 	v2.HandleFunc("/daos/{id}/delegates", h.getDelegatesV2).Methods(http.MethodGet).Name("get_delegates_v2_list")
-	v2.HandleFunc("/daos/{id}/delegates/{address}/delegators", h.getUserDelegatorsV2).Methods(http.MethodGet).Name("get_delegators_v2_list")
-	v2.HandleFunc("/daos/{id}/delegates/{address}/delegators/top", h.getUserDelegatorsTopV2).Methods(http.MethodGet).Name("get_delegators_v2_top")
+	v2.HandleFunc("/daos/{id}/delegates/{address}/delegators", withResolvedAddress(h.resolver, h.getUserDelegatorsV2)).Methods(http.MethodGet).Name("get_delegators_v2_list")
+	v2.HandleFunc("/daos/{id}/delegates/{address}/delegators/top", withResolvedAddress(h.resolver, h.getUserDelegatorsTopV2)).Methods(http.MethodGet).Name("get_delegators_v2_top")
+}
+
+func (h *DAO) Describe() []openapi.Route {
+	delegateAddress := map[string]string{"address": "Delegate address or ENS name"}
+
+	return []openapi.Route{
+		{
+			Method:   http.MethodGet,
+			Path:     "/v1/daos/top",
+			Summary:  "Top DAOs grouped by category",
+			Tags:     []string{tagDao},
+			Query:    openapi.ParamsOf(forms.GetTop{}),
+			Response: dao.TopCategories{},
+		},
+		{
+			Method:   http.MethodGet,
+			Path:     "/v1/daos/recommendations",
+			Summary:  "DAO recommendations",
+			Tags:     []string{tagDao},
+			Response: dao.Recommendations{},
+		},
+		{
+			Method:    http.MethodGet,
+			Path:      "/v1/daos/{id}/feed",
+			Summary:   "DAO feed",
+			Tags:      []string{tagDao, tagFeed},
+			Query:     openapi.ParamsOf(forms.GetFeed{}),
+			Paginated: true,
+			Response:  []dao.FeedItem{},
+		},
+		{
+			Method:       http.MethodGet,
+			Path:         "/v1/daos/{id}/feed.atom",
+			Summary:      "DAO feed as Atom 1.0",
+			Tags:         []string{tagDao, tagFeed},
+			Query:        openapi.ParamsOf(forms.GetFeed{}),
+			Response:     "",
+			ContentTypes: []string{syndication.ContentTypeAtom},
+		},
+		{
+			Method:       http.MethodGet,
+			Path:         "/v1/daos/{id}/feed.rss",
+			Summary:      "DAO feed as RSS 2.0",
+			Tags:         []string{tagDao, tagFeed},
+			Query:        openapi.ParamsOf(forms.GetFeed{}),
+			Response:     "",
+			ContentTypes: []string{syndication.ContentTypeRSS},
+		},
+		{
+			Method:       http.MethodGet,
+			Path:         "/v1/daos/{id}/proposals.ics",
+			Summary:      "iCalendar with voting windows of active and upcoming DAO proposals",
+			Tags:         []string{tagDao, tagProposals},
+			Response:     "",
+			ContentTypes: []string{ical.ContentType},
+		},
+		{
+			Method:   http.MethodGet,
+			Path:     "/v1/daos/{id}",
+			Summary:  "DAO by identifier",
+			Tags:     []string{tagDao},
+			Query:    openapi.ParamsOf(forms.GetByID{}),
+			Response: dao.Dao{},
+		},
+		{
+			Method:   http.MethodGet,
+			Path:     "/v1/daos/{id}/overview",
+			Summary:  "DAO overview with token info, active proposals, top delegates and feed",
+			Tags:     []string{tagDao},
+			Query:    openapi.ParamsOf(forms.GetOverview{}),
+			Response: overview.DaoOverview{},
+		},
+		{
+			Method:    http.MethodGet,
+			Path:      "/v1/daos",
+			Summary:   "DAO list",
+			Tags:      []string{tagDao},
+			Query:     openapi.ParamsOf(forms.GetList{}),
+			Paginated: true,
+			Response:  []dao.Dao{},
+		},
+		{
+			Method:    http.MethodGet,
+			Path:      "/v1/daos/{id}/delegates",
+			Summary:   "DAO delegates",
+			Tags:      []string{tagDao, tagDelegates},
+			Query:     openapi.ParamsOf(forms.GetDelegates{}),
+			Paginated: true,
+			Response:  dao.DelegatesResponse{},
+			Headers:   []string{response.HeaderResolvedAddress, response.HeaderResolvedEnsName},
+		},
+		{
+			Method:   http.MethodGet,
+			Path:     "/v1/daos/{id}/delegate-profile",
+			Summary:  "Delegate profile in the DAO",
+			Tags:     []string{tagDao, tagDelegates},
+			Query:    openapi.ParamsOf(forms.GetDelegateProfile{}),
+			Response: dao.DelegateProfile{},
+			Headers:  []string{response.HeaderResolvedAddress, response.HeaderResolvedEnsName},
+		},
+		{
+			Method:     http.MethodGet,
+			Path:       "/v1/daos/{id}/delegates/{address}/delegators",
+			PathParams: delegateAddress,
+			Summary:    "Delegators of the delegate in the DAO",
+			Tags:       []string{tagDao, tagDelegates},
+			Query:      openapi.ParamsOf(forms.GetDelegators{}),
+			Paginated:  true,
+			Headers:    []string{response.HeaderNextCursor, response.HeaderResolvedAddress, response.HeaderResolvedEnsName},
+			Response:   []dao.Delegator{},
+		},
+		{
+			Method:   http.MethodGet,
+			Path:     "/v1/daos/{id}/token-info",
+			Summary:  "DAO token info",
+			Tags:     []string{tagDao},
+			Response: dao.TokenInfo{},
+		},
+		{
+			Method:   http.MethodGet,
+			Path:     "/v1/daos/{id}/token-chart",
+			Summary:  "DAO token price chart",
+			Tags:     []string{tagDao},
+			Query:    []openapi.Param{{Name: "period", Description: "Chart period", Type: "string"}},
+			Response: dao.TokenChart{},
+		},
+		{
+			Method:   http.MethodPost,
+			Path:     "/v1/daos/{id}/populate-token-price",
+			Summary:  "Populate DAO token prices",
+			Tags:     []string{tagDao},
+			Response: true,
+		},
+		{
+			Method:   http.MethodPost,
+			Path:     "/v1/daos/update-fungible-ids",
+			Summary:  "Update token fungible identifiers",
+			Tags:     []string{tagDao},
+			Query:    []openapi.Param{{Name: "category", Description: "Category", Type: "string"}},
+			Response: true,
+		},
+		{
+			Method:    http.MethodGet,
+			Path:      "/v2/daos/{id}/delegates",
+			Summary:   "DAO delegates",
+			Tags:      []string{tagDao, tagDelegates},
+			Query:     openapi.ParamsOf(forms.GetDelegatesV2{}),
+			Paginated: true,
+			Response:  delegate.GetDelegatesV2Response{},
+		},
+		{
+			Method:     http.MethodGet,
+			Path:       "/v2/daos/{id}/delegates/{address}/delegators",
+			PathParams: delegateAddress,
+			Summary:    "Delegators of the delegate in the DAO",
+			Tags:       []string{tagDao, tagDelegates},
+			Query:      openapi.ParamsOf(forms.GetDelegatorsV2{}),
+			Paginated:  true,
+			Response:   delegate.GetDelegatorsV2Response{},
+			Headers:    []string{response.HeaderResolvedAddress, response.HeaderResolvedEnsName},
+		},
+		{
+			Method:     http.MethodGet,
+			Path:       "/v2/daos/{id}/delegates/{address}/delegators/top",
+			PathParams: delegateAddress,
+			Summary:    "Top delegators of the delegate in the DAO",
+			Tags:       []string{tagDao, tagDelegates},
+			Response:   delegate.GetDelegatorsV2Response{},
+			Headers:    []string{response.HeaderResolvedAddress, response.HeaderResolvedEnsName},
+		},
+	}
 }
 
 func (h *DAO) getByIDAction(w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
 	id := vars["id"]
 
+	form, verr := forms.NewGetByIDForm().ParseAndValidate(r)
+	if verr != nil {
+		response.HandleError(verr, w)
+
+		return
+	}
+
+	params := form.(*forms.GetByID)
 	resp, err := h.dc.GetByID(r.Context(), &storagepb.DaoByIDRequest{DaoId: id})
 	if err != nil {
 		log.Error().Err(err).Fields(map[string]interface{}{
@@ -73,7 +273,7 @@ func (h *DAO) getByIDAction(w http.ResponseWriter, r *http.Request) {
 	}
 
 	w.WriteHeader(http.StatusOK)
-	_ = json.NewEncoder(w).Encode(convertToDaoFromProto(resp.Dao))
+	_ = json.NewEncoder(w).Encode(fieldset.Select(convertToDaoFromProto(resp.Dao), params.Fields))
 }
 
 func (h *DAO) getFeedByIDAction(w http.ResponseWriter, r *http.Request) {
@@ -109,10 +309,83 @@ func (h *DAO) getFeedByIDAction(w http.ResponseWriter, r *http.Request) {
 		list[i] = convertToFeedItemFromProto(fi)
 	}
 
-	response.AddPaginationHeaders(w, params.Offset, params.Limit, resp.TotalCount)
+	response.AddPaginationHeaders(w, r, params.Offset, params.Limit, resp.TotalCount)
 	_ = json.NewEncoder(w).Encode(list)
 }
 
+// getSyndicationFeedAction renders the DAO feed for feed readers, the DAO is loaded for the feed title.
+func (h *DAO) getSyndicationFeedAction(format syndicationFormat) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		id := vars["id"]
+
+		form, verr := forms.NewGetFeedForm().ParseAndValidate(r)
+		if verr != nil {
+			response.HandleError(verr, w)
+
+			return
+		}
+
+		params := form.(*forms.GetFeed)
+		daoResp, err := h.dc.GetByID(r.Context(), &storagepb.DaoByIDRequest{DaoId: id})
+		if err != nil {
+			log.Error().Err(err).Str("id", id).Msg("get dao for syndication feed")
+			response.HandleError(response.ResolveError(err), w)
+
+			return
+		}
+
+		resp, err := h.fc.GetByFilter(r.Context(), &feedpb.FeedByFilterRequest{
+			DaoId:  &id,
+			Types:  []string{"proposal"},
+			Limit:  &params.Limit,
+			Offset: &params.Offset,
+		})
+		if err != nil {
+			log.Error().Err(err).Str("id", id).Msg("get feed by dao for syndication feed")
+			response.HandleError(response.ResolveError(err), w)
+
+			return
+		}
+
+		info := daoResp.GetDao()
+		writeSyndicationFeed(w, format, newSyndicationFeed(
+			selfURL(h.publicBaseURL, r),
+			"urn:uuid:"+info.GetId(),
+			info.GetName(),
+			fmt.Sprintf("Proposals of %s on Goverland", info.GetName()),
+			resp.GetItems(),
+			info.GetUpdatedAt().AsTime(),
+		))
+	}
+}
+
+// getCalendarAction builds the calendar of the DAO proposals, the DAO is loaded for the calendar name.
+func (h *DAO) getCalendarAction(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id := vars["id"]
+
+	daoResp, err := h.dc.GetByID(r.Context(), &storagepb.DaoByIDRequest{DaoId: id})
+	if err != nil {
+		log.Error().Err(err).Str("id", id).Msg("get dao for calendar")
+		response.HandleError(response.ResolveError(err), w)
+
+		return
+	}
+
+	proposals, err := loadCalendarProposals(r.Context(), h.pc, id)
+	if err != nil {
+		log.Error().Err(err).Str("id", id).Msg("get dao calendar proposals")
+		response.HandleError(response.ResolveError(err), w)
+
+		return
+	}
+
+	info := daoResp.GetDao()
+	names := map[string]string{info.GetId(): info.GetName()}
+	writeCalendar(w, newProposalsCalendar(info.GetName()+" proposals", proposals, names))
+}
+
 func convertToFeedItemFromProto(fi *feedpb.FeedInfo) dao.FeedItem {
 	itemID, _ := uuid.Parse(fi.GetId())
 	daoID, _ := uuid.Parse(fi.GetDaoId())
@@ -206,9 +479,9 @@ func (h *DAO) getListAction(w http.ResponseWriter, r *http.Request) {
 		resp[i] = convertToDaoFromProto(info)
 	}
 
-	response.AddPaginationHeaders(w, params.Offset, params.Limit, list.TotalCount)
+	response.AddPaginationHeaders(w, r, params.Offset, params.Limit, list.TotalCount)
 
-	_ = json.NewEncoder(w).Encode(resp)
+	_ = json.NewEncoder(w).Encode(fieldset.Select(resp, params.Fields))
 }
 
 func (h *DAO) getTopAction(w http.ResponseWriter, r *http.Request) {
@@ -263,7 +536,7 @@ func (h *DAO) getRecommendations(w http.ResponseWriter, r *http.Request) {
 			Name:       info.GetName(),
 			Symbol:     info.GetSymbol(),
 			NetworkId:  info.GetNetworkId(),
-			Address:    info.GetAddress(),
+			Address:    address.Checksum(info.GetAddress()),
 		})
 	}
 
@@ -274,7 +547,7 @@ func (h *DAO) getDelegates(w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
 	daoID := vars["id"]
 
-	form, verr := forms.NewGetDelegatesForm().ParseAndValidate(r)
+	form, verr := forms.NewGetDelegatesForm(h.resolver).ParseAndValidate(r)
 	if verr != nil {
 		response.HandleError(verr, w)
 
@@ -282,6 +555,7 @@ func (h *DAO) getDelegates(w http.ResponseWriter, r *http.Request) {
 	}
 
 	params := form.(*forms.GetDelegates)
+	addResolvedIdentifierHeaders(w, params.Resolved)
 
 	// TODO: for now we only support one address in query
 	var qAccounts []string
@@ -309,7 +583,7 @@ func (h *DAO) getDelegates(w http.ResponseWriter, r *http.Request) {
 	convertedDelegates := make([]dao.Delegate, 0, len(resp.Delegates))
 	for _, info := range resp.Delegates {
 		convertedDelegates = append(convertedDelegates, dao.Delegate{
-			Address:               info.GetAddress(),
+			Address:               address.Checksum(info.GetAddress()),
 			ENSName:               info.GetEnsName(),
 			DelegatorCount:        info.GetDelegatorCount(),
 			PercentOfDelegators:   info.GetPercentOfDelegators(),
@@ -324,11 +598,15 @@ func (h *DAO) getDelegates(w http.ResponseWriter, r *http.Request) {
 		})
 	}
 
+	enrichDelegateEnsNames(r.Context(), h.ens, convertedDelegates)
+
 	result := dao.DelegatesResponse{
 		Delegates: convertedDelegates,
 		Total:     resp.Total,
 	}
 
+	response.AddPaginationHeaders(w, r, params.Offset, params.Limit, uint64(resp.Total))
+
 	_ = json.NewEncoder(w).Encode(result)
 }
 
@@ -360,7 +638,7 @@ func (h *DAO) getDelegateProfile(w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
 	daoID := vars["id"]
 
-	form, verr := forms.NewGetDelegateProfileForm().ParseAndValidate(r)
+	form, verr := forms.NewGetDelegateProfileForm(h.resolver).ParseAndValidate(r)
 	if verr != nil {
 		response.HandleError(verr, w)
 
@@ -368,6 +646,7 @@ func (h *DAO) getDelegateProfile(w http.ResponseWriter, r *http.Request) {
 	}
 
 	params := form.(*forms.GetDelegateProfile)
+	addResolvedIdentifierHeaders(w, params.Resolved)
 
 	resp, err := h.delegateClient.GetDelegateProfile(r.Context(), &storagepb.GetDelegateProfileRequest{
 		DaoId:          daoID,
@@ -385,13 +664,15 @@ func (h *DAO) getDelegateProfile(w http.ResponseWriter, r *http.Request) {
 	delegates := make([]dao.ProfileDelegateItem, 0, len(resp.Delegates))
 	for _, info := range resp.Delegates {
 		delegates = append(delegates, dao.ProfileDelegateItem{
-			Address:        info.GetAddress(),
+			Address:        address.Checksum(info.GetAddress()),
 			ENSName:        info.GetEnsName(),
 			Weight:         info.GetWeight(),
 			DelegatedPower: info.GetDelegatedPower(),
 		})
 	}
 
+	enrichProfileDelegateEnsNames(r.Context(), h.ens, delegates)
+
 	var expiration *time.Time
 	if resp.GetExpiration() != nil {
 		exp := resp.GetExpiration().AsTime()
@@ -399,7 +680,7 @@ func (h *DAO) getDelegateProfile(w http.ResponseWriter, r *http.Request) {
 	}
 
 	result := dao.DelegateProfile{
-		Address:              resp.GetAddress(),
+		Address:              address.Checksum(resp.GetAddress()),
 		VotingPower:          resp.GetVotingPower(),
 		IncomingPower:        resp.GetIncomingPower(),
 		OutgoingPower:        resp.GetOutgoingPower(),
@@ -415,7 +696,7 @@ func (h *DAO) getDelegateProfile(w http.ResponseWriter, r *http.Request) {
 func (h *DAO) getDelegators(w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
 	daoID := vars["id"]
-	address := vars["address"]
+	delegateAddress := resolvedAddress(r)
 
 	form, verr := forms.NewGetDelegatorsForm().ParseAndValidate(r)
 	if verr != nil {
@@ -425,30 +706,39 @@ func (h *DAO) getDelegators(w http.ResponseWriter, r *http.Request) {
 	}
 
 	params := form.(*forms.GetDelegators)
-	resp, err := h.delegateClient.GetDelegators(r.Context(), &storagepb.GetDelegatorsRequest{
-		DaoId:   daoID,
-		Address: address,
-		ChainId: params.ChainID,
-		Limit:   uint32(params.Limit),
-		Offset:  helpers.Ptr(uint32(params.Offset)),
-	})
+	delegators, offset, total, cursor, err := cursorList(params.CursorPagination, func(offset, limit uint64) ([]*storagepb.DelegatorEntry, uint64, error) {
+		resp, err := h.delegateClient.GetDelegators(r.Context(), &storagepb.GetDelegatorsRequest{
+			DaoId:   daoID,
+			Address: delegateAddress,
+			ChainId: params.ChainID,
+			Limit:   uint32(limit),
+			Offset:  helpers.Ptr(uint32(offset)),
+		})
+		if err != nil {
+			return nil, 0, err
+		}
+
+		return resp.GetList(), uint64(resp.GetTotalCount()), nil
+	}, delegatorCursorKey)
 	if err != nil {
 		log.Error().Err(err).Msg("get delegators")
 		response.HandleError(response.ResolveError(err), w)
 
 		return
 	}
-
-	convertedDelegators := make([]dao.Delegator, 0, len(resp.GetList()))
-	for _, info := range resp.GetList() {
+	convertedDelegators := make([]dao.Delegator, 0, len(delegators))
+	for _, info := range delegators {
 		convertedDelegators = append(convertedDelegators, dao.Delegator{
-			Address:    info.GetAddress(),
+			Address:    address.Checksum(info.GetAddress()),
 			ENSName:    info.GetEnsName(),
 			TokenValue: info.GetTokenValue(),
 		})
 	}
 
-	response.AddPaginationHeaders(w, params.Offset, params.Limit, uint64(resp.GetTotalCount()))
+	enrichDelegatorEnsNames(r.Context(), h.ens, convertedDelegators)
+
+	response.AddPaginationHeaders(w, r, offset, params.Limit, total)
+	response.AddNextCursorHeader(w, cursor)
 
 	_ = json.NewEncoder(w).Encode(convertedDelegators)
 }
@@ -466,6 +756,11 @@ func (h *DAO) getTokenInfo(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(convertToTokenInfoFromProto(resp))
+}
+
+func convertToTokenInfoFromProto(resp *storagepb.TokenInfoResponse) dao.TokenInfo {
 	convertedChains := make([]dao.TokenChainInfo, 0, len(resp.Chains))
 	for _, info := range resp.Chains {
 		convertedChains = append(convertedChains, dao.TokenChainInfo{
@@ -473,11 +768,11 @@ func (h *DAO) getTokenInfo(w http.ResponseWriter, r *http.Request) {
 			Name:     info.GetName(),
 			Decimals: int(info.GetDecimals()),
 			IconURL:  info.GetIconUrl(),
-			Address:  info.GetAddress(),
+			Address:  address.Checksum(info.GetAddress()),
 		})
 	}
 
-	ti := dao.TokenInfo{
+	return dao.TokenInfo{
 		Name:                  resp.GetName(),
 		Symbol:                resp.GetSymbol(),
 		TotalSupply:           resp.GetTotalSupply(),
@@ -488,9 +783,6 @@ func (h *DAO) getTokenInfo(w http.ResponseWriter, r *http.Request) {
 		FungibleID:            resp.GetFungibleId(),
 		Chains:                convertedChains,
 	}
-
-	w.WriteHeader(http.StatusOK)
-	_ = json.NewEncoder(w).Encode(ti)
 }
 
 func (h *DAO) getTokenChart(w http.ResponseWriter, r *http.Request) {
@@ -626,7 +918,7 @@ func convertToTreasuresFromProto(info []*storagepb.Treasury) dao.Treasuries {
 	for i, details := range info {
 		res[i] = dao.Treasury{
 			Name:    details.GetName(),
-			Address: details.GetAddress(),
+			Address: address.Checksum(details.GetAddress()),
 			Network: details.GetNetwork(),
 		}
 	}
@@ -0,0 +1,119 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/goverland-labs/goverland-core-feed/protocol/feedpb"
+	"github.com/goverland-labs/goverland-core-storage/protocol/storagepb"
+
+	"github.com/goverland-labs/goverland-core-web-api/internal/response"
+	forms "github.com/goverland-labs/goverland-core-web-api/internal/rest/form/dao"
+	"github.com/goverland-labs/goverland-core-web-api/internal/rest/models/dao"
+	"github.com/goverland-labs/goverland-core-web-api/internal/rest/models/delegate"
+	"github.com/goverland-labs/goverland-core-web-api/internal/rest/models/overview"
+	"github.com/goverland-labs/goverland-core-web-api/pkg/helpers"
+)
+
+const topDelegatesSort = "voting_power"
+
+// getOverviewAction loads the sections of the DAO page in parallel. A failed section is reported in the errors
+// of the document, the request fails only when the DAO does not exist.
+func (h *DAO) getOverviewAction(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id := vars["id"]
+
+	form, verr := forms.NewGetOverviewForm().ParseAndValidate(r)
+	if verr != nil {
+		response.HandleError(verr, w)
+
+		return
+	}
+
+	params := form.(*forms.GetOverview)
+	sections := map[string]section[overview.DaoOverview]{
+		overview.SectionDao: func(ctx context.Context, result *overview.DaoOverview) error {
+			resp, err := h.dc.GetByID(ctx, &storagepb.DaoByIDRequest{DaoId: id})
+			if err != nil {
+				return err
+			}
+
+			result.Dao = helpers.Ptr(convertToDaoFromProto(resp.GetDao()))
+
+			return nil
+		},
+		overview.SectionTokenInfo: func(ctx context.Context, result *overview.DaoOverview) error {
+			resp, err := h.dc.GetTokenInfo(ctx, &storagepb.TokenInfoRequest{DaoId: id})
+			if err != nil {
+				return err
+			}
+
+			result.TokenInfo = helpers.Ptr(convertToTokenInfoFromProto(resp))
+
+			return nil
+		},
+		overview.SectionActiveProposals: func(ctx context.Context, result *overview.DaoOverview) error {
+			resp, err := h.pc.GetByFilter(ctx, &storagepb.ProposalByFilterRequest{
+				Dao:        &id,
+				Limit:      &params.Proposals,
+				OnlyActive: helpers.Ptr(true),
+			})
+			if err != nil {
+				return err
+			}
+
+			result.ActiveProposals = convertToProposalListFromProto(resp, false)
+
+			return nil
+		},
+		overview.SectionTopDelegates: func(ctx context.Context, result *overview.DaoOverview) error {
+			resp, err := h.delegateClient.GetDelegatesV2(ctx, &storagepb.GetDelegatesV2Request{
+				DaoId:          id,
+				Sort:           helpers.Ptr(topDelegatesSort),
+				Limit:          int32(params.Delegates),
+				DelegationType: convertDelegationTypeToProto(""),
+			})
+			if err != nil {
+				return err
+			}
+
+			result.TopDelegates = make([]*delegate.DelegatesWrapper, 0, len(resp.GetList()))
+			for _, info := range resp.GetList() {
+				result.TopDelegates = append(result.TopDelegates, convertDelegateWrapperToModel(info))
+			}
+
+			return nil
+		},
+		overview.SectionFeed: func(ctx context.Context, result *overview.DaoOverview) error {
+			resp, err := h.fc.GetByFilter(ctx, &feedpb.FeedByFilterRequest{
+				DaoId: &id,
+				Types: []string{"proposal"},
+				Limit: &params.Feed,
+			})
+			if err != nil {
+				return err
+			}
+
+			result.Feed = make([]dao.FeedItem, len(resp.GetItems()))
+			for i, fi := range resp.GetItems() {
+				result.Feed[i] = convertToFeedItemFromProto(fi)
+			}
+
+			return nil
+		},
+	}
+
+	var result overview.DaoOverview
+	errs := loadSections(r.Context(), &result, sections, map[string]interface{}{"id": id})
+	if err, ok := errs[overview.SectionDao]; ok && err.GetHTTPStatus() == http.StatusNotFound {
+		response.HandleError(err, w)
+
+		return
+	}
+
+	result.Errors = convertToSectionErrors(errs)
+
+	_ = json.NewEncoder(w).Encode(result)
+}
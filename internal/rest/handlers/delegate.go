@@ -14,44 +14,137 @@ import (
 	ihelpers "github.com/goverland-labs/goverland-core-web-api/internal/helpers"
 	"github.com/goverland-labs/goverland-core-web-api/internal/response"
 	"github.com/goverland-labs/goverland-core-web-api/internal/rest/form/common"
+	daoforms "github.com/goverland-labs/goverland-core-web-api/internal/rest/form/dao"
 	"github.com/goverland-labs/goverland-core-web-api/internal/rest/models/delegate"
+	"github.com/goverland-labs/goverland-core-web-api/internal/rest/openapi"
+	"github.com/goverland-labs/goverland-core-web-api/pkg/address"
 )
 
 type Delegate struct {
 	dc       storagepb.DelegateClient
 	resolver *ihelpers.IdentifierResolver
+	ens      *ihelpers.EnsEnricher
 }
 
-func NewDelegateHandler(dc storagepb.DelegateClient, resolver *ihelpers.IdentifierResolver) APIHandler {
+func NewDelegateHandler(dc storagepb.DelegateClient, resolver *ihelpers.IdentifierResolver, ens *ihelpers.EnsEnricher) APIHandler {
 	return &Delegate{
 		dc:       dc,
 		resolver: resolver,
+		ens:      ens,
 	}
 }
 
 func (h *Delegate) EnrichRoutes(v1, v2 *mux.Router) {
-	v1.HandleFunc("/user/{address}/delegates/top", h.getDelegatesByAddress).Methods(http.MethodGet).Name("get_delegates_by_address")
-	v1.HandleFunc("/user/{address}/delegators/top", h.getDelegatorsByAddress).Methods(http.MethodGet).Name("get_delegators_by_address")
-	v1.HandleFunc("/user/{address}/delegations/total", h.getTotalDelegations).Methods(http.MethodGet).Name("get_delegates_summary_by_address")
-	v1.HandleFunc("/user/{address}/delegates/{dao_id}/list", h.getDelegatesList).Methods(http.MethodGet).Name("get_delegates_list")
-	v1.HandleFunc("/user/{address}/delegators/{dao_id}/list", h.getDelegatorsList).Methods(http.MethodGet).Name("get_delegators_list")
-
-	v2.HandleFunc("/user/{address}/delegates/top", h.getUserDelegatesTopV2).Methods(http.MethodGet).Name("get_user_delegates_top_v2")
-	v2.HandleFunc("/user/{address}/delegators/top", h.getUserDelegatorsTopV2).Methods(http.MethodGet).Name("get_user_delegates_top_v2")
-	v2.HandleFunc("/user/{address}/delegates/{dao_id}/list", h.getUserDelegatesListV2).Methods(http.MethodGet).Name("get_delegates_list")
-	v2.HandleFunc("/user/{address}/delegators/{dao_id}/list", h.getUserDelegatorsListV2).Methods(http.MethodGet).Name("get_delegators_list")
+	v1.HandleFunc("/user/{address}/delegates/top", withResolvedAddress(h.resolver, h.getDelegatesByAddress)).Methods(http.MethodGet).Name("get_delegates_by_address")
+	v1.HandleFunc("/user/{address}/delegators/top", withResolvedAddress(h.resolver, h.getDelegatorsByAddress)).Methods(http.MethodGet).Name("get_delegators_by_address")
+	v1.HandleFunc("/user/{address}/delegations/total", withResolvedAddress(h.resolver, h.getTotalDelegations)).Methods(http.MethodGet).Name("get_delegates_summary_by_address")
+	v1.HandleFunc("/user/{address}/delegates/{dao_id}/list", withResolvedAddress(h.resolver, h.getDelegatesList)).Methods(http.MethodGet).Name("get_delegates_list")
+	v1.HandleFunc("/user/{address}/delegators/{dao_id}/list", withResolvedAddress(h.resolver, h.getDelegatorsList)).Methods(http.MethodGet).Name("get_delegators_list")
+
+	v2.HandleFunc("/user/{address}/delegates/top", withResolvedAddress(h.resolver, h.getUserDelegatesTopV2)).Methods(http.MethodGet).Name("get_user_delegates_top_v2")
+	v2.HandleFunc("/user/{address}/delegators/top", withResolvedAddress(h.resolver, h.getUserDelegatorsTopV2)).Methods(http.MethodGet).Name("get_user_delegates_top_v2")
+	v2.HandleFunc("/user/{address}/delegates/{dao_id}/list", withResolvedAddress(h.resolver, h.getUserDelegatesListV2)).Methods(http.MethodGet).Name("get_delegates_list")
+	v2.HandleFunc("/user/{address}/delegators/{dao_id}/list", withResolvedAddress(h.resolver, h.getUserDelegatorsListV2)).Methods(http.MethodGet).Name("get_delegators_list")
 }
 
-func (h *Delegate) getDelegatesByAddress(w http.ResponseWriter, r *http.Request) {
-	vars := mux.Vars(r)
-	resolved, err := h.resolver.Resolve(r.Context(), vars["address"])
-	if err != nil {
-		log.Error().Err(err).Str("identifier", vars["address"]).Msg("resolve identifier for delegates by address")
-		response.HandleError(response.ResolveError(err), w)
-
-		return
+func (h *Delegate) Describe() []openapi.Route {
+	address := map[string]string{"address": "User address or ENS name"}
+
+	return []openapi.Route{
+		{
+			Method:     http.MethodGet,
+			Path:       "/v1/user/{address}/delegates/top",
+			Summary:    "Top delegates of the user grouped by DAO",
+			Tags:       []string{tagUser, tagDelegates},
+			PathParams: address,
+			Response:   delegate.TopDelegates{},
+			Headers:    []string{response.HeaderResolvedAddress, response.HeaderResolvedEnsName},
+		},
+		{
+			Method:     http.MethodGet,
+			Path:       "/v1/user/{address}/delegators/top",
+			Summary:    "Top delegators of the user grouped by DAO",
+			Tags:       []string{tagUser, tagDelegates},
+			PathParams: address,
+			Response:   delegate.TopDelegators{},
+			Headers:    []string{response.HeaderResolvedAddress, response.HeaderResolvedEnsName},
+		},
+		{
+			Method:     http.MethodGet,
+			Path:       "/v1/user/{address}/delegations/total",
+			Summary:    "Total delegations of the user",
+			Tags:       []string{tagUser, tagDelegates},
+			PathParams: address,
+			Response:   delegate.TotalDelegations{},
+			Headers:    []string{response.HeaderResolvedAddress, response.HeaderResolvedEnsName},
+		},
+		{
+			Method:     http.MethodGet,
+			Path:       "/v1/user/{address}/delegates/{dao_id}/list",
+			Summary:    "Delegates of the user in the DAO",
+			Tags:       []string{tagUser, tagDelegates},
+			PathParams: address,
+			Query:      openapi.ParamsOf(common.Pagination{}),
+			Paginated:  true,
+			Response:   delegate.DelegatesList{},
+			Headers:    []string{response.HeaderResolvedAddress, response.HeaderResolvedEnsName},
+		},
+		{
+			Method:     http.MethodGet,
+			Path:       "/v1/user/{address}/delegators/{dao_id}/list",
+			Summary:    "Delegators of the user in the DAO",
+			Tags:       []string{tagUser, tagDelegates},
+			PathParams: address,
+			Query:      openapi.ParamsOf(common.Pagination{}),
+			Paginated:  true,
+			Response:   delegate.DelegatorsList{},
+			Headers:    []string{response.HeaderResolvedAddress, response.HeaderResolvedEnsName},
+		},
+		{
+			Method:     http.MethodGet,
+			Path:       "/v2/user/{address}/delegates/top",
+			Summary:    "Top delegates of the user grouped by DAO",
+			Tags:       []string{tagUser, tagDelegates},
+			PathParams: address,
+			Response:   delegate.GetUserDelegatesTopV2Response{},
+			Headers:    []string{response.HeaderResolvedAddress, response.HeaderResolvedEnsName},
+		},
+		{
+			Method:     http.MethodGet,
+			Path:       "/v2/user/{address}/delegators/top",
+			Summary:    "Top delegators of the user grouped by DAO",
+			Tags:       []string{tagUser, tagDelegates},
+			PathParams: address,
+			Response:   delegate.GetUserDelegatorsTopV2Response{},
+			Headers:    []string{response.HeaderResolvedAddress, response.HeaderResolvedEnsName},
+		},
+		{
+			Method:     http.MethodGet,
+			Path:       "/v2/user/{address}/delegates/{dao_id}/list",
+			Summary:    "Delegates of the user in the DAO",
+			Tags:       []string{tagUser, tagDelegates},
+			PathParams: address,
+			Query:      openapi.ParamsOf(daoforms.GetUserDelegatesV2{}),
+			Paginated:  true,
+			Response:   delegate.GetUserDelegatesV2Response{},
+			Headers:    []string{response.HeaderResolvedAddress, response.HeaderResolvedEnsName},
+		},
+		{
+			Method:     http.MethodGet,
+			Path:       "/v2/user/{address}/delegators/{dao_id}/list",
+			Summary:    "Delegators of the user in the DAO",
+			Tags:       []string{tagUser, tagDelegates},
+			PathParams: address,
+			Query:      openapi.ParamsOf(daoforms.GetUserDelegatorsV2{}),
+			Paginated:  true,
+			Response:   delegate.GetUserDelegatorsV2Response{},
+			Headers:    []string{response.HeaderResolvedAddress, response.HeaderResolvedEnsName},
+		},
 	}
-	address := resolved.Address
+}
+
+func (h *Delegate) getDelegatesByAddress(w http.ResponseWriter, r *http.Request) {
+	address := resolvedAddress(r)
 
 	resp, err := h.dc.GetTopDelegates(r.Context(), &storagepb.GetTopDelegatesRequest{Address: address})
 	if err != nil {
@@ -64,20 +157,15 @@ func (h *Delegate) getDelegatesByAddress(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
+	result := convertToTopDelegatesFromProto(resp)
+	enrichDelegationEnsNames(r.Context(), h.ens, summaryDelegations(result.List)...)
+
 	w.WriteHeader(http.StatusOK)
-	_ = json.NewEncoder(w).Encode(convertToTopDelegatesFromProto(resp))
+	_ = json.NewEncoder(w).Encode(result)
 }
 
 func (h *Delegate) getDelegatorsByAddress(w http.ResponseWriter, r *http.Request) {
-	vars := mux.Vars(r)
-	resolved, err := h.resolver.Resolve(r.Context(), vars["address"])
-	if err != nil {
-		log.Error().Err(err).Str("identifier", vars["address"]).Msg("resolve identifier for delegators by address")
-		response.HandleError(response.ResolveError(err), w)
-
-		return
-	}
-	address := resolved.Address
+	address := resolvedAddress(r)
 
 	resp, err := h.dc.GetTopDelegators(r.Context(), &storagepb.GetTopDelegatorsRequest{Address: address})
 	if err != nil {
@@ -90,20 +178,15 @@ func (h *Delegate) getDelegatorsByAddress(w http.ResponseWriter, r *http.Request
 		return
 	}
 
+	result := convertToTopDelegatorsFromProto(resp)
+	enrichDelegationEnsNames(r.Context(), h.ens, summaryDelegations(result.List)...)
+
 	w.WriteHeader(http.StatusOK)
-	_ = json.NewEncoder(w).Encode(convertToTopDelegatorsFromProto(resp))
+	_ = json.NewEncoder(w).Encode(result)
 }
 
 func (h *Delegate) getTotalDelegations(w http.ResponseWriter, r *http.Request) {
-	vars := mux.Vars(r)
-	resolved, err := h.resolver.Resolve(r.Context(), vars["address"])
-	if err != nil {
-		log.Error().Err(err).Str("identifier", vars["address"]).Msg("resolve identifier for total delegations")
-		response.HandleError(response.ResolveError(err), w)
-
-		return
-	}
-	address := resolved.Address
+	address := resolvedAddress(r)
 
 	resp, err := h.dc.GetDelegationSummary(r.Context(), &storagepb.GetDelegationSummaryRequest{Address: address})
 	if err != nil {
@@ -169,14 +252,7 @@ func convertToTopDelegatorsFromProto(info *storagepb.GetTopDelegatorsResponse) d
 
 func (h *Delegate) getDelegatesList(w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
-	resolved, err := h.resolver.Resolve(r.Context(), vars["address"])
-	if err != nil {
-		log.Error().Err(err).Str("identifier", vars["address"]).Msg("resolve identifier for delegates list")
-		response.HandleError(response.ResolveError(err), w)
-
-		return
-	}
-	address := resolved.Address
+	address := resolvedAddress(r)
 	daoID := vars["dao_id"]
 
 	form, verr := common.NewPagination().ParseAndValidate(r)
@@ -202,8 +278,13 @@ func (h *Delegate) getDelegatesList(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	result := convertToDelegatesListFromProto(resp)
+	enrichDelegationEnsNames(r.Context(), h.ens, result.List)
+
+	response.AddPaginationHeaders(w, r, params.Offset, params.Limit, uint64(resp.GetTotalCount()))
+
 	w.WriteHeader(http.StatusOK)
-	_ = json.NewEncoder(w).Encode(convertToDelegatesListFromProto(resp))
+	_ = json.NewEncoder(w).Encode(result)
 }
 
 func convertToDelegatesListFromProto(info *storagepb.GetDelegatesByDaoResponse) delegate.DelegatesList {
@@ -244,7 +325,7 @@ func convertDelegationToModel(info *storagepb.DelegationDetails) delegate.Delega
 	}
 
 	return delegate.DelegationDetails{
-		Address:             info.GetAddress(),
+		Address:             address.Checksum(info.GetAddress()),
 		EnsName:             info.GetEnsName(),
 		PercentOfDelegators: int(info.GetPercentOfDelegators()),
 		Expiration:          exp,
@@ -253,14 +334,7 @@ func convertDelegationToModel(info *storagepb.DelegationDetails) delegate.Delega
 
 func (h *Delegate) getDelegatorsList(w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
-	resolved, err := h.resolver.Resolve(r.Context(), vars["address"])
-	if err != nil {
-		log.Error().Err(err).Str("identifier", vars["address"]).Msg("resolve identifier for delegators list")
-		response.HandleError(response.ResolveError(err), w)
-
-		return
-	}
-	address := resolved.Address
+	address := resolvedAddress(r)
 	daoID := vars["dao_id"]
 
 	form, verr := common.NewPagination().ParseAndValidate(r)
@@ -286,6 +360,11 @@ func (h *Delegate) getDelegatorsList(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	result := convertToDelegatorsListFromProto(resp)
+	enrichDelegationEnsNames(r.Context(), h.ens, result.List)
+
+	response.AddPaginationHeaders(w, r, params.Offset, params.Limit, uint64(resp.GetTotalCount()))
+
 	w.WriteHeader(http.StatusOK)
-	_ = json.NewEncoder(w).Encode(convertToDelegatorsListFromProto(resp))
+	_ = json.NewEncoder(w).Encode(result)
 }
@@ -13,6 +13,7 @@ import (
 	"github.com/goverland-labs/goverland-core-web-api/internal/response"
 	forms "github.com/goverland-labs/goverland-core-web-api/internal/rest/form/dao"
 	"github.com/goverland-labs/goverland-core-web-api/internal/rest/models/delegate"
+	"github.com/goverland-labs/goverland-core-web-api/pkg/address"
 )
 
 func (h *DAO) getDelegatesV2(w http.ResponseWriter, r *http.Request) {
@@ -54,12 +55,15 @@ func (h *DAO) getDelegatesV2(w http.ResponseWriter, r *http.Request) {
 	for _, v := range resp.List {
 		list = append(list, convertDelegateWrapperToModel(v))
 	}
+	enrichDelegatesWrapperEnsNames(r.Context(), h.ens, list)
 
 	result := delegate.GetDelegatesV2Response{
 		List:     list,
 		TotalCnt: resp.TotalCnt,
 	}
 
+	response.AddPaginationHeaders(w, r, params.Offset, params.Limit, uint64(resp.TotalCnt))
+
 	_ = json.NewEncoder(w).Encode(result)
 }
 
@@ -103,7 +107,7 @@ func convertDelegateEntryV2ToModel(entry *proto.DelegateEntryV2) *delegate.Deleg
 	}
 
 	return &delegate.DelegateEntryV2{
-		Address:               entry.GetAddress(),
+		Address:               address.Checksum(entry.GetAddress()),
 		EnsName:               entry.GetEnsName(),
 		DelegatorCount:        entry.DelegatorCount,
 		PercentOfDelegators:   entry.PercentOfDelegators,
@@ -121,7 +125,7 @@ func convertDelegateEntryV2ToModel(entry *proto.DelegateEntryV2) *delegate.Deleg
 func (h *DAO) getUserDelegatorsV2(w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
 	daoID := vars["id"]
-	address := vars["address"]
+	delegateAddress := resolvedAddress(r)
 
 	form, verr := forms.NewGetDelegatorsV2Form().ParseAndValidate(r)
 	if verr != nil {
@@ -133,7 +137,7 @@ func (h *DAO) getUserDelegatorsV2(w http.ResponseWriter, r *http.Request) {
 	params := form.(*forms.GetDelegatorsV2)
 	resp, err := h.delegateClient.GetDelegatorsV2(r.Context(), &proto.GetDelegatorsV2Request{
 		DaoId:          daoID,
-		QueryAccounts:  []string{address},
+		QueryAccounts:  []string{delegateAddress},
 		Limit:          int32(params.Limit),
 		Offset:         int32(params.Offset),
 		DelegationType: convertDelegationTypeToProto(pointy.StringValue(params.DelegationType, "")),
@@ -150,23 +154,26 @@ func (h *DAO) getUserDelegatorsV2(w http.ResponseWriter, r *http.Request) {
 	for _, v := range resp.List {
 		list = append(list, convertDelegateWrapperToModel(v))
 	}
+	enrichDelegatesWrapperEnsNames(r.Context(), h.ens, list)
 
 	result := delegate.GetDelegatorsV2Response{
 		List:     list,
 		TotalCnt: resp.TotalCnt,
 	}
 
+	response.AddPaginationHeaders(w, r, params.Offset, params.Limit, uint64(resp.TotalCnt))
+
 	_ = json.NewEncoder(w).Encode(result)
 }
 
 func (h *DAO) getUserDelegatorsTopV2(w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
 	daoID := vars["id"]
-	address := vars["address"]
+	delegateAddress := resolvedAddress(r)
 
 	resp, err := h.delegateClient.GetTopDelegatorsV2(r.Context(), &proto.GetTopDelegatorsV2Request{
 		DaoId:   &daoID,
-		Address: address,
+		Address: delegateAddress,
 	})
 	if err != nil {
 		log.Error().Err(err).Msg("get dao user top delegators v2")
@@ -179,6 +186,7 @@ func (h *DAO) getUserDelegatorsTopV2(w http.ResponseWriter, r *http.Request) {
 	for _, v := range resp.List {
 		list = append(list, convertDelegateWrapperToModel(v))
 	}
+	enrichDelegatesWrapperEnsNames(r.Context(), h.ens, list)
 
 	result := delegate.GetDelegatorsV2Response{
 		List:     list,
@@ -189,15 +197,7 @@ func (h *DAO) getUserDelegatorsTopV2(w http.ResponseWriter, r *http.Request) {
 }
 
 func (h *Delegate) getUserDelegatesTopV2(w http.ResponseWriter, r *http.Request) {
-	vars := mux.Vars(r)
-	resolved, err := h.resolver.Resolve(r.Context(), vars["address"])
-	if err != nil {
-		log.Error().Err(err).Str("identifier", vars["address"]).Msg("resolve identifier for user delegates top v2")
-		response.HandleError(response.ResolveError(err), w)
-
-		return
-	}
-	address := resolved.Address
+	address := resolvedAddress(r)
 
 	resp, err := h.dc.GetTopDelegatesV2(r.Context(), &proto.GetTopDelegatesV2Request{Address: address})
 	if err != nil {
@@ -214,6 +214,7 @@ func (h *Delegate) getUserDelegatesTopV2(w http.ResponseWriter, r *http.Request)
 	for _, v := range resp.List {
 		list = append(list, convertDelegateWrapperToModel(v))
 	}
+	enrichDelegatesWrapperEnsNames(r.Context(), h.ens, list)
 
 	result := delegate.GetUserDelegatesTopV2Response{
 		List:     list,
@@ -225,15 +226,8 @@ func (h *Delegate) getUserDelegatesTopV2(w http.ResponseWriter, r *http.Request)
 
 func (h *Delegate) getUserDelegatesListV2(w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
-	resolved, err := h.resolver.Resolve(r.Context(), vars["address"])
-	if err != nil {
-		log.Error().Err(err).Str("identifier", vars["address"]).Msg("resolve identifier for user delegates list v2")
-		response.HandleError(response.ResolveError(err), w)
-
-		return
-	}
 	daoID := vars["dao_id"]
-	address := resolved.Address
+	address := resolvedAddress(r)
 
 	form, verr := forms.NewGetUserDelegatesV2Form().ParseAndValidate(r)
 	if verr != nil {
@@ -263,25 +257,20 @@ func (h *Delegate) getUserDelegatesListV2(w http.ResponseWriter, r *http.Request
 	for _, v := range resp.List {
 		list = append(list, convertDelegateWrapperToModel(v))
 	}
+	enrichDelegatesWrapperEnsNames(r.Context(), h.ens, list)
 
 	result := delegate.GetUserDelegatesV2Response{
 		List:     list,
 		TotalCnt: resp.TotalCnt,
 	}
 
+	response.AddPaginationHeaders(w, r, params.Offset, params.Limit, uint64(resp.TotalCnt))
+
 	_ = json.NewEncoder(w).Encode(result)
 }
 
 func (h *Delegate) getUserDelegatorsTopV2(w http.ResponseWriter, r *http.Request) {
-	vars := mux.Vars(r)
-	resolved, err := h.resolver.Resolve(r.Context(), vars["address"])
-	if err != nil {
-		log.Error().Err(err).Str("identifier", vars["address"]).Msg("resolve identifier for user delegators top v2")
-		response.HandleError(response.ResolveError(err), w)
-
-		return
-	}
-	address := resolved.Address
+	address := resolvedAddress(r)
 
 	resp, err := h.dc.GetTopDelegatorsV2(r.Context(), &proto.GetTopDelegatorsV2Request{Address: address})
 	if err != nil {
@@ -298,6 +287,7 @@ func (h *Delegate) getUserDelegatorsTopV2(w http.ResponseWriter, r *http.Request
 	for _, v := range resp.List {
 		list = append(list, convertDelegateWrapperToModel(v))
 	}
+	enrichDelegatesWrapperEnsNames(r.Context(), h.ens, list)
 
 	result := delegate.GetUserDelegatorsTopV2Response{
 		List:     list,
@@ -309,15 +299,8 @@ func (h *Delegate) getUserDelegatorsTopV2(w http.ResponseWriter, r *http.Request
 
 func (h *Delegate) getUserDelegatorsListV2(w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
-	resolved, err := h.resolver.Resolve(r.Context(), vars["address"])
-	if err != nil {
-		log.Error().Err(err).Str("identifier", vars["address"]).Msg("resolve identifier for user delegators list v2")
-		response.HandleError(response.ResolveError(err), w)
-
-		return
-	}
 	daoID := vars["dao_id"]
-	address := resolved.Address
+	address := resolvedAddress(r)
 
 	form, verr := forms.NewGetUserDelegatorsV2Form().ParseAndValidate(r)
 	if verr != nil {
@@ -352,11 +335,14 @@ func (h *Delegate) getUserDelegatorsListV2(w http.ResponseWriter, r *http.Reques
 	for _, v := range resp.List {
 		list = append(list, convertDelegateWrapperToModel(v))
 	}
+	enrichDelegatesWrapperEnsNames(r.Context(), h.ens, list)
 
 	result := delegate.GetUserDelegatorsV2Response{
 		List:     list,
 		TotalCnt: resp.TotalCnt,
 	}
 
+	response.AddPaginationHeaders(w, r, params.Offset, params.Limit, uint64(resp.TotalCnt))
+
 	_ = json.NewEncoder(w).Encode(result)
 }
@@ -12,6 +12,8 @@ import (
 
 	"github.com/goverland-labs/goverland-core-web-api/internal/response"
 	"github.com/goverland-labs/goverland-core-web-api/internal/rest/models/ens"
+	"github.com/goverland-labs/goverland-core-web-api/internal/rest/openapi"
+	"github.com/goverland-labs/goverland-core-web-api/pkg/address"
 )
 
 type Ens struct {
@@ -29,6 +31,27 @@ func (h *Ens) EnrichRoutes(v1, _ *mux.Router) {
 	v1.HandleFunc("/ens-address", h.getAddressesByNamesAction).Methods(http.MethodGet).Name("get_addresses_by_names")
 }
 
+func (h *Ens) Describe() []openapi.Route {
+	return []openapi.Route{
+		{
+			Method:   http.MethodGet,
+			Path:     "/v1/ens-name",
+			Summary:  "Resolve addresses to ENS names",
+			Tags:     []string{tagEns},
+			Query:    openapi.ParamsOf(forms.GetEnsNames{}),
+			Response: []ens.EnsName{},
+		},
+		{
+			Method:   http.MethodGet,
+			Path:     "/v1/ens-address",
+			Summary:  "Resolve ENS names to addresses",
+			Tags:     []string{tagEns},
+			Query:    openapi.ParamsOf(forms.GetAddressesByNames{}),
+			Response: []ens.EnsName{},
+		},
+	}
+}
+
 func (h *Ens) getEnsNamesAction(w http.ResponseWriter, r *http.Request) {
 	form, ferr := forms.NewGetEnsNamesForm().ParseAndValidate(r)
 	if ferr != nil {
@@ -85,7 +108,7 @@ func (h *Ens) getAddressesByNamesAction(w http.ResponseWriter, r *http.Request)
 
 func convertToEnsNameFromProto(info *storagepb.EnsName) ens.EnsName {
 	return ens.EnsName{
-		Address: info.GetAddress(),
+		Address: address.Checksum(info.GetAddress()),
 		Name:    info.GetName(),
 	}
 }
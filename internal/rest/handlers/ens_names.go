@@ -0,0 +1,102 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/rs/zerolog/log"
+
+	ihelpers "github.com/goverland-labs/goverland-core-web-api/internal/helpers"
+	"github.com/goverland-labs/goverland-core-web-api/internal/rest/models/dao"
+	"github.com/goverland-labs/goverland-core-web-api/internal/rest/models/delegate"
+	"github.com/goverland-labs/goverland-core-web-api/internal/rest/models/proposal"
+)
+
+// enrichEnsNames fills the collected names with one batched lookup, the response is sent as is when the lookup fails.
+func enrichEnsNames(ctx context.Context, ens *ihelpers.EnsEnricher, names *ihelpers.EnsNames) {
+	if err := ens.Enrich(ctx, names); err != nil {
+		log.Warn().Err(err).Int("addresses", names.Len()).Msg("enrich response with ens names")
+	}
+}
+
+func enrichProposalEnsNames(ctx context.Context, ens *ihelpers.EnsEnricher, list []proposal.Proposal) {
+	names := ihelpers.NewEnsNames()
+	for i := range list {
+		names.Add(list[i].Author, &list[i].EnsName)
+	}
+
+	enrichEnsNames(ctx, ens, names)
+}
+
+func enrichVoteEnsNames(ctx context.Context, ens *ihelpers.EnsEnricher, list []proposal.Vote) {
+	names := ihelpers.NewEnsNames()
+	for i := range list {
+		names.Add(list[i].Voter, &list[i].EnsName)
+	}
+
+	enrichEnsNames(ctx, ens, names)
+}
+
+func enrichDelegateEnsNames(ctx context.Context, ens *ihelpers.EnsEnricher, list []dao.Delegate) {
+	names := ihelpers.NewEnsNames()
+	for i := range list {
+		names.Add(list[i].Address, &list[i].ENSName)
+	}
+
+	enrichEnsNames(ctx, ens, names)
+}
+
+func enrichProfileDelegateEnsNames(ctx context.Context, ens *ihelpers.EnsEnricher, list []dao.ProfileDelegateItem) {
+	names := ihelpers.NewEnsNames()
+	for i := range list {
+		names.Add(list[i].Address, &list[i].ENSName)
+	}
+
+	enrichEnsNames(ctx, ens, names)
+}
+
+func enrichDelegatorEnsNames(ctx context.Context, ens *ihelpers.EnsEnricher, list []dao.Delegator) {
+	names := ihelpers.NewEnsNames()
+	for i := range list {
+		names.Add(list[i].Address, &list[i].ENSName)
+	}
+
+	enrichEnsNames(ctx, ens, names)
+}
+
+func enrichDelegationEnsNames(ctx context.Context, ens *ihelpers.EnsEnricher, lists ...[]delegate.DelegationDetails) {
+	names := ihelpers.NewEnsNames()
+	for _, list := range lists {
+		for i := range list {
+			names.Add(list[i].Address, &list[i].EnsName)
+		}
+	}
+
+	enrichEnsNames(ctx, ens, names)
+}
+
+// summaryDelegations returns the delegations of every summary to be enriched at once.
+func summaryDelegations(summaries []delegate.DelegationSummary) [][]delegate.DelegationDetails {
+	lists := make([][]delegate.DelegationDetails, 0, len(summaries))
+	for i := range summaries {
+		lists = append(lists, summaries[i].List)
+	}
+
+	return lists
+}
+
+func enrichDelegatesWrapperEnsNames(ctx context.Context, ens *ihelpers.EnsEnricher, list []*delegate.DelegatesWrapper) {
+	names := ihelpers.NewEnsNames()
+	for _, wrapper := range list {
+		if wrapper == nil {
+			continue
+		}
+
+		for _, entry := range wrapper.Delegates {
+			if entry != nil {
+				names.Add(entry.Address, &entry.EnsName)
+			}
+		}
+	}
+
+	enrichEnsNames(ctx, ens, names)
+}
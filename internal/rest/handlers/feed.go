@@ -9,22 +9,99 @@ import (
 	"github.com/rs/zerolog/log"
 
 	"github.com/goverland-labs/goverland-core-web-api/internal/response"
+	"github.com/goverland-labs/goverland-core-web-api/internal/rest/form/common"
 	forms "github.com/goverland-labs/goverland-core-web-api/internal/rest/form/feed"
 	"github.com/goverland-labs/goverland-core-web-api/internal/rest/models/dao"
+	"github.com/goverland-labs/goverland-core-web-api/internal/rest/openapi"
+	"github.com/goverland-labs/goverland-core-web-api/pkg/syndication"
 )
 
 type Feed struct {
-	fc feedpb.FeedClient
+	fc            feedpb.FeedClient
+	publicBaseURL string
 }
 
-func NewFeedHandler(fc feedpb.FeedClient) APIHandler {
+func NewFeedHandler(fc feedpb.FeedClient, publicBaseURL string) APIHandler {
 	return &Feed{
-		fc: fc,
+		fc:            fc,
+		publicBaseURL: publicBaseURL,
 	}
 }
 
 func (h *Feed) EnrichRoutes(v1, _ *mux.Router) {
 	v1.HandleFunc("/feed", h.getFeedByFiltersAction).Methods(http.MethodPost).Name("get_feed_by_filters")
+	v1.HandleFunc("/feed.atom", h.getSyndicationFeedAction(atomFormat)).Methods(http.MethodGet).Name("get_feed_atom")
+	v1.HandleFunc("/feed.rss", h.getSyndicationFeedAction(rssFormat)).Methods(http.MethodGet).Name("get_feed_rss")
+}
+
+func (h *Feed) Describe() []openapi.Route {
+	return []openapi.Route{
+		{
+			Method:    http.MethodPost,
+			Path:      "/v1/feed",
+			Summary:   "Feed by filters",
+			Tags:      []string{tagFeed},
+			Query:     openapi.ParamsOf(common.CursorPagination{}),
+			Body:      forms.GetFeedRequest{},
+			Paginated: true,
+			Headers:   []string{response.HeaderNextCursor},
+			Response:  []dao.FeedItem{},
+		},
+		{
+			Method:       http.MethodGet,
+			Path:         "/v1/feed.atom",
+			Summary:      "Feed by filters as Atom 1.0",
+			Tags:         []string{tagFeed},
+			Query:        openapi.ParamsOf(forms.GetFeedSyndication{}),
+			Response:     "",
+			ContentTypes: []string{syndication.ContentTypeAtom},
+		},
+		{
+			Method:       http.MethodGet,
+			Path:         "/v1/feed.rss",
+			Summary:      "Feed by filters as RSS 2.0",
+			Tags:         []string{tagFeed},
+			Query:        openapi.ParamsOf(forms.GetFeedSyndication{}),
+			Response:     "",
+			ContentTypes: []string{syndication.ContentTypeRSS},
+		},
+	}
+}
+
+// getSyndicationFeedAction renders the feed for feed readers, the filters are given in the query.
+func (h *Feed) getSyndicationFeedAction(format syndicationFormat) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		form, verr := forms.NewGetFeedSyndicationForm().ParseAndValidate(r)
+		if verr != nil {
+			response.HandleError(verr, w)
+
+			return
+		}
+
+		params := form.(*forms.GetFeedSyndication)
+		resp, err := h.fc.GetByFilter(r.Context(), &feedpb.FeedByFilterRequest{
+			DaoIds:   params.DaoList,
+			Types:    params.Types,
+			Actions:  params.Actions,
+			IsActive: params.IsActive,
+			Limit:    &params.Limit,
+		})
+		if err != nil {
+			log.Error().Err(err).Fields(params.ConvertToMap()).Msg("get feed for syndication feed")
+			response.HandleError(response.ResolveError(err), w)
+
+			return
+		}
+
+		writeSyndicationFeed(w, format, newSyndicationFeed(
+			selfURL(h.publicBaseURL, r),
+			params.URN(),
+			"Goverland feed",
+			"Proposals and DAO updates on Goverland",
+			resp.GetItems(),
+			emptyFeedUpdated,
+		))
+	}
 }
 
 func (h *Feed) getFeedByFiltersAction(w http.ResponseWriter, r *http.Request) {
@@ -36,14 +113,21 @@ func (h *Feed) getFeedByFiltersAction(w http.ResponseWriter, r *http.Request) {
 	}
 
 	params := form.(*forms.GetFeedList)
-	resp, err := h.fc.GetByFilter(r.Context(), &feedpb.FeedByFilterRequest{
-		DaoIds:   params.DaoList,
-		Types:    params.Types,
-		Actions:  params.Actions,
-		IsActive: params.IsActive,
-		Limit:    &params.Limit,
-		Offset:   &params.Offset,
-	})
+	items, offset, total, cursor, err := cursorList(params.CursorPagination, func(offset, limit uint64) ([]*feedpb.FeedInfo, uint64, error) {
+		resp, err := h.fc.GetByFilter(r.Context(), &feedpb.FeedByFilterRequest{
+			DaoIds:   params.DaoList,
+			Types:    params.Types,
+			Actions:  params.Actions,
+			IsActive: params.IsActive,
+			Limit:    &limit,
+			Offset:   &offset,
+		})
+		if err != nil {
+			return nil, 0, err
+		}
+
+		return resp.GetItems(), resp.GetTotalCount(), nil
+	}, feedCursorKey)
 	if err != nil {
 		log.Error().Err(err).Msg("get feed by filters")
 
@@ -51,12 +135,12 @@ func (h *Feed) getFeedByFiltersAction(w http.ResponseWriter, r *http.Request) {
 
 		return
 	}
-
-	list := make([]dao.FeedItem, len(resp.Items))
-	for i, fi := range resp.Items {
+	list := make([]dao.FeedItem, len(items))
+	for i, fi := range items {
 		list[i] = convertToFeedItemFromProto(fi)
 	}
 
-	response.AddPaginationHeaders(w, params.Offset, params.Limit, resp.TotalCount)
+	response.AddPaginationHeaders(w, r, offset, params.Limit, total)
+	response.AddNextCursorHeader(w, cursor)
 	_ = json.NewEncoder(w).Encode(list)
 }
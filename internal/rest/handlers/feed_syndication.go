@@ -0,0 +1,150 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/goverland-labs/goverland-core-feed/protocol/feedpb"
+	"github.com/rs/zerolog/log"
+
+	"github.com/goverland-labs/goverland-core-web-api/internal/rest/models/dao"
+	"github.com/goverland-labs/goverland-core-web-api/pkg/syndication"
+)
+
+const feedAuthor = "Goverland"
+
+// emptyFeedUpdated is the stable update time of the feed without entries and any other time to fall back to.
+var emptyFeedUpdated = time.Unix(0, 0).UTC()
+
+// syndicationFormat is the rendering of the feed for feed readers.
+type syndicationFormat struct {
+	contentType string
+	write       func(w io.Writer, f syndication.Feed) error
+}
+
+var (
+	atomFormat = syndicationFormat{contentType: syndication.ContentTypeAtom, write: syndication.WriteAtom}
+	rssFormat  = syndicationFormat{contentType: syndication.ContentTypeRSS, write: syndication.WriteRSS}
+)
+
+var feedActionTitles = map[dao.TimelineAction]string{
+	dao.DaoCreated:                  "New DAO",
+	dao.DaoUpdated:                  "DAO updated",
+	dao.ProposalCreated:             "New proposal",
+	dao.ProposalUpdated:             "Proposal updated",
+	dao.ProposalVotingStartsSoon:    "Voting starts soon",
+	dao.ProposalVotingStarted:       "Voting started",
+	dao.ProposalVotingQuorumReached: "Quorum reached",
+	dao.ProposalVotingEnded:         "Voting ended",
+}
+
+// feedSnapshot contains the fields of the DAO and proposal snapshots used in the feed entries.
+type feedSnapshot struct {
+	Title  string `json:"title"`
+	Name   string `json:"name"`
+	Link   string `json:"link"`
+	Author string `json:"author"`
+}
+
+func writeSyndicationFeed(w http.ResponseWriter, format syndicationFormat, feed syndication.Feed) {
+	w.Header().Set("Content-Type", format.contentType+"; charset=utf-8")
+	if err := format.write(w, feed); err != nil {
+		log.Warn().Err(err).Str("feed", feed.ID).Msg("write syndication feed")
+	}
+}
+
+// newSyndicationFeed builds the feed of the items, the feed is updated with the latest entry or at the fallback time
+// when there are no entries.
+func newSyndicationFeed(self, id, title, description string, items []*feedpb.FeedInfo, fallback time.Time) syndication.Feed {
+	feed := syndication.Feed{
+		ID:          id,
+		Title:       title,
+		Description: description,
+		Self:        self,
+		Author:      feedAuthor,
+		Updated:     fallback,
+		Entries:     make([]syndication.Entry, len(items)),
+	}
+
+	for i, fi := range items {
+		entry := convertToSyndicationEntryFromProto(fi)
+		if i == 0 || entry.Updated.After(feed.Updated) {
+			feed.Updated = entry.Updated
+		}
+		feed.Entries[i] = entry
+	}
+
+	return feed
+}
+
+func convertToSyndicationEntryFromProto(fi *feedpb.FeedInfo) syndication.Entry {
+	item := convertToFeedItemFromProto(fi)
+
+	var snapshot feedSnapshot
+	if len(item.Snapshot) > 0 {
+		if err := json.Unmarshal(item.Snapshot, &snapshot); err != nil {
+			log.Warn().Err(err).Str("id", fi.GetId()).Msg("parse feed item snapshot")
+		}
+	}
+
+	updated := item.UpdatedAt
+	summary := make([]string, 0, len(item.Timeline))
+	for _, t := range item.Timeline {
+		if t.CreatedAt.After(updated) {
+			updated = t.CreatedAt
+		}
+		if label, ok := feedActionTitles[t.Action]; ok {
+			summary = append(summary, fmt.Sprintf("%s at %s", label, t.CreatedAt.UTC().Format("2006-01-02 15:04 MST")))
+		}
+	}
+
+	return syndication.Entry{
+		ID:         "urn:uuid:" + item.ID.String(),
+		Title:      feedEntryTitle(item, snapshot),
+		Link:       snapshot.Link,
+		Author:     snapshot.Author,
+		Summary:    strings.Join(summary, "\n"),
+		Categories: []string{item.Type},
+		Published:  item.CreatedAt,
+		Updated:    updated,
+	}
+}
+
+// feedEntryTitle combines the action of the item with the title of the proposal or the name of the DAO.
+func feedEntryTitle(item dao.FeedItem, snapshot feedSnapshot) string {
+	subject := snapshot.Title
+	if subject == "" {
+		subject = snapshot.Name
+	}
+	if subject == "" {
+		subject = item.ProposalID
+	}
+
+	action := dao.TimelineAction(item.Action)
+	if action == dao.None && len(item.Timeline) > 0 {
+		action = item.Timeline[len(item.Timeline)-1].Action
+	}
+
+	label, ok := feedActionTitles[action]
+	switch {
+	case !ok:
+		return subject
+	case subject == "":
+		return label
+	default:
+		return label + ": " + subject
+	}
+}
+
+// selfURL returns the public URL of the request, the feed has no self link when the public base URL is not configured.
+func selfURL(publicBaseURL string, r *http.Request) string {
+	if publicBaseURL == "" {
+		return ""
+	}
+
+	return strings.TrimSuffix(publicBaseURL, "/") + r.URL.RequestURI()
+}
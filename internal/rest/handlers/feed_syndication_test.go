@@ -0,0 +1,92 @@
+package handlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/goverland-labs/goverland-core-feed/protocol/feedpb"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	forms "github.com/goverland-labs/goverland-core-web-api/internal/rest/form/feed"
+)
+
+func TestConvertToSyndicationEntryFromProto(t *testing.T) {
+	created := time.Date(2024, 2, 1, 12, 0, 0, 0, time.UTC)
+	ended := time.Date(2024, 2, 4, 12, 30, 0, 0, time.UTC)
+
+	entry := convertToSyndicationEntryFromProto(&feedpb.FeedInfo{
+		Id:         "7d1f6c62-3f7b-4f6e-9d0a-2b1c3d4e5f60",
+		CreatedAt:  timestamppb.New(created),
+		UpdatedAt:  timestamppb.New(created),
+		ProposalId: "0x1",
+		Type:       feedpb.FeedInfo_Proposal,
+		Action:     "proposal.voting.ended",
+		Snapshot:   &anypb.Any{Value: []byte(`{"title":"Enable stable rate","link":"https://snapshot.org/#/aave.eth/proposal/0x1","author":"0x329c54289Ff5D6B7b7daE13592C6B1EDA1543eD4"}`)},
+		Timeline: []*feedpb.FeedTimelineItem{
+			{CreatedAt: timestamppb.New(created), Action: feedpb.FeedTimelineItem_ProposalCreated},
+			{CreatedAt: timestamppb.New(ended), Action: feedpb.FeedTimelineItem_ProposalVotingEnded},
+		},
+	})
+
+	if entry.ID != "urn:uuid:7d1f6c62-3f7b-4f6e-9d0a-2b1c3d4e5f60" {
+		t.Errorf("ID = %q", entry.ID)
+	}
+	if entry.Title != "Voting ended: Enable stable rate" {
+		t.Errorf("Title = %q", entry.Title)
+	}
+	if entry.Link != "https://snapshot.org/#/aave.eth/proposal/0x1" || entry.Author != "0x329c54289Ff5D6B7b7daE13592C6B1EDA1543eD4" {
+		t.Errorf("Link = %q, Author = %q", entry.Link, entry.Author)
+	}
+	if !entry.Published.Equal(created) || !entry.Updated.Equal(ended) {
+		t.Errorf("Published = %s, Updated = %s, want %s and %s", entry.Published, entry.Updated, created, ended)
+	}
+	if want := "New proposal at 2024-02-01 12:00 UTC\nVoting ended at 2024-02-04 12:30 UTC"; entry.Summary != want {
+		t.Errorf("Summary = %q, want %q", entry.Summary, want)
+	}
+}
+
+func TestConvertToSyndicationEntryFromProto_DaoWithoutAction(t *testing.T) {
+	entry := convertToSyndicationEntryFromProto(&feedpb.FeedInfo{
+		Id:       "7d1f6c62-3f7b-4f6e-9d0a-2b1c3d4e5f60",
+		Type:     feedpb.FeedInfo_DAO,
+		Snapshot: &anypb.Any{Value: []byte(`{"name":"Aave"}`)},
+		Timeline: []*feedpb.FeedTimelineItem{
+			{CreatedAt: timestamppb.Now(), Action: feedpb.FeedTimelineItem_DaoUpdated},
+		},
+	})
+
+	if entry.Title != "DAO updated: Aave" {
+		t.Errorf("Title = %q", entry.Title)
+	}
+	if len(entry.Categories) != 1 || entry.Categories[0] != "dao" {
+		t.Errorf("Categories = %v", entry.Categories)
+	}
+}
+
+func TestSyndicationFeed_Stable(t *testing.T) {
+	r := httptest.NewRequest(http.MethodGet, "http://evil.example.com/v1/feed.atom?types=proposal&dao_list=B&dao_list=a", nil)
+	r.Header.Set("X-Forwarded-Proto", "gopher")
+
+	feed := newSyndicationFeed(selfURL("https://api.example.com/", r), "urn:goverland:feed:1", "Goverland feed", "", nil, emptyFeedUpdated)
+	if want := "https://api.example.com/v1/feed.atom?types=proposal&dao_list=B&dao_list=a"; feed.Self != want {
+		t.Errorf("Self = %q, want %q", feed.Self, want)
+	}
+	if !feed.Updated.Equal(time.Unix(0, 0)) {
+		t.Errorf("Updated = %s, want the unix epoch", feed.Updated)
+	}
+	if got := selfURL("", r); got != "" {
+		t.Errorf("Self without public base URL = %q, want empty", got)
+	}
+
+	ordered := forms.GetFeedSyndication{DaoList: []string{"a", "b"}, Types: []string{"proposal"}, Limit: 20}
+	shuffled := forms.GetFeedSyndication{DaoList: []string{"B", "a", "b"}, Types: []string{"proposal"}, Limit: 50}
+	if ordered.URN() != shuffled.URN() {
+		t.Errorf("URN depends on the order of the filters: %q and %q", ordered.URN(), shuffled.URN())
+	}
+	if other := (&forms.GetFeedSyndication{DaoList: []string{"a"}}).URN(); other == ordered.URN() {
+		t.Errorf("URN of other filters = %q, want it to differ", other)
+	}
+}
@@ -0,0 +1,108 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/goverland-labs/goverland-core-storage/protocol/storagepb"
+
+	"github.com/goverland-labs/goverland-core-web-api/internal/rest/form/common"
+	"github.com/goverland-labs/goverland-core-web-api/internal/rest/models/dao"
+	"github.com/goverland-labs/goverland-core-web-api/internal/rest/models/proposal"
+)
+
+// shortProposalFields are the fields returned by the storage on the short proposal info level.
+var shortProposalFields = []string{"id", "title", "state", "created"}
+
+// loadCompactDaos fetches DAOs by identifiers in one request, duplicated identifiers are requested once.
+func loadCompactDaos(ctx context.Context, dc storagepb.DaoClient, ids []uuid.UUID) (map[uuid.UUID]*dao.Compact, error) {
+	unique := make([]string, 0, len(ids))
+	seen := make(map[uuid.UUID]struct{}, len(ids))
+	for _, id := range ids {
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		unique = append(unique, id.String())
+	}
+
+	result := make(map[uuid.UUID]*dao.Compact, len(unique))
+	if len(unique) == 0 {
+		return result, nil
+	}
+
+	limit := uint64(len(unique))
+	list, err := dc.GetByFilter(ctx, &storagepb.DaoByFilterRequest{
+		DaoIds: unique,
+		Limit:  &limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, info := range list.GetDaos() {
+		compact := convertToCompactDaoFromProto(info)
+		result[compact.ID] = compact
+	}
+
+	return result, nil
+}
+
+func convertToCompactDaoFromProto(info *storagepb.DaoInfo) *dao.Compact {
+	id, _ := uuid.Parse(info.GetId())
+
+	return &dao.Compact{
+		ID:       id,
+		Alias:    info.GetAlias(),
+		Name:     info.GetName(),
+		Avatar:   info.GetAvatar(),
+		Symbol:   info.GetSymbol(),
+		Network:  info.GetNetwork(),
+		Verified: info.GetVerified(),
+	}
+}
+
+// responseFields returns the fields to keep in the response, embedded relations are kept as well.
+func responseFields(fields common.Fieldset, include common.Include) []string {
+	if len(fields.Fields) == 0 || !include.Has(common.IncludeDao) {
+		return fields.Fields
+	}
+
+	return append(append([]string{}, fields.Fields...), common.IncludeDao)
+}
+
+func includeProposalDaos(ctx context.Context, dc storagepb.DaoClient, list []proposal.Proposal) error {
+	ids := make([]uuid.UUID, len(list))
+	for i := range list {
+		ids[i] = list[i].DaoID
+	}
+
+	daos, err := loadCompactDaos(ctx, dc, ids)
+	if err != nil {
+		return err
+	}
+
+	for i := range list {
+		list[i].Dao = daos[list[i].DaoID]
+	}
+
+	return nil
+}
+
+func includeVoteDaos(ctx context.Context, dc storagepb.DaoClient, list []proposal.Vote) error {
+	ids := make([]uuid.UUID, len(list))
+	for i := range list {
+		ids[i] = list[i].DaoID
+	}
+
+	daos, err := loadCompactDaos(ctx, dc, ids)
+	if err != nil {
+		return err
+	}
+
+	for i := range list {
+		list[i].Dao = daos[list[i].DaoID]
+	}
+
+	return nil
+}
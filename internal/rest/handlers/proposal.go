@@ -1,8 +1,10 @@
 package handlers
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
+	"time"
 
 	"github.com/golang/protobuf/ptypes/any"
 	"github.com/google/uuid"
@@ -10,37 +12,182 @@ import (
 	"github.com/goverland-labs/goverland-core-storage/protocol/storagepb"
 	"github.com/rs/zerolog/log"
 
+	ihelpers "github.com/goverland-labs/goverland-core-web-api/internal/helpers"
 	"github.com/goverland-labs/goverland-core-web-api/internal/response"
+	"github.com/goverland-labs/goverland-core-web-api/internal/rest/fieldset"
+	"github.com/goverland-labs/goverland-core-web-api/internal/rest/form/common"
 	forms "github.com/goverland-labs/goverland-core-web-api/internal/rest/form/proposal"
 	"github.com/goverland-labs/goverland-core-web-api/internal/rest/models/proposal"
+	"github.com/goverland-labs/goverland-core-web-api/internal/rest/openapi"
+	"github.com/goverland-labs/goverland-core-web-api/internal/results"
+	"github.com/goverland-labs/goverland-core-web-api/pkg/address"
+	"github.com/goverland-labs/goverland-core-web-api/pkg/ttlcache"
 )
 
 type Proposal struct {
-	pc storagepb.ProposalClient
-	vc storagepb.VoteClient
+	pc       storagepb.ProposalClient
+	vc       storagepb.VoteClient
+	dc       storagepb.DaoClient
+	resolver *ihelpers.IdentifierResolver
+	ens      *ihelpers.EnsEnricher
+
+	// preparedVotes are kept by the prepared vote identifier
+	preparedVotes *ttlcache.Cache[string, preparedVote]
+	// resultsVotes are kept by the proposal identifier and its vote count
+	resultsVotes *ttlcache.Cache[string, []results.Vote]
 }
 
-func NewProposalHandler(pc storagepb.ProposalClient, vc storagepb.VoteClient) APIHandler {
+func NewProposalHandler(pc storagepb.ProposalClient, vc storagepb.VoteClient, dc storagepb.DaoClient, resolver *ihelpers.IdentifierResolver, ens *ihelpers.EnsEnricher, preparedVoteTTL time.Duration) APIHandler {
 	return &Proposal{
-		pc: pc,
-		vc: vc,
+		pc:            pc,
+		vc:            vc,
+		dc:            dc,
+		resolver:      resolver,
+		ens:           ens,
+		preparedVotes: ttlcache.New[string, preparedVote](preparedVoteTTL),
+		resultsVotes:  ttlcache.NewBounded[string, []results.Vote](resultsVotesCacheTTL, resultsVotesCacheSize),
 	}
 }
 
 func (h *Proposal) EnrichRoutes(v1, _ *mux.Router) {
 	v1.HandleFunc("/proposals/top", h.getTopAction).Methods(http.MethodGet).Name("get_proposals_top")
+	v1.HandleFunc("/proposals/{id}/results", h.getResultsAction).Methods(http.MethodGet).Name("get_proposal_results")
 	v1.HandleFunc("/proposals/{id}/votes", h.getVotesAction).Methods(http.MethodGet).Name("get_proposal_votes")
 	v1.HandleFunc("/proposals/{id}/votes/validate", h.validateVote).Methods(http.MethodPost).Name("proposal_vote_validate")
+	v1.HandleFunc("/proposals/{id}/votes/simulate", h.simulateVote).Methods(http.MethodPost).Name("proposal_vote_simulate")
 	v1.HandleFunc("/proposals/{id}/votes/prepare", h.prepareVote).Methods(http.MethodPost).Name("proposal_vote_prepare")
 	v1.HandleFunc("/proposals/votes", h.vote).Methods(http.MethodPost).Name("proposal_vote")
 	v1.HandleFunc("/proposals/{id}", h.getByIDAction).Methods(http.MethodGet).Name("get_proposal_by_id")
 	v1.HandleFunc("/proposals", h.getListAction).Methods(http.MethodGet).Name("get_proposals_list")
 }
 
+func (h *Proposal) EnrichStreamRoutes(v1 *mux.Router) {
+	v1.HandleFunc("/proposals/{id}/votes/export", h.exportVotesAction).Methods(http.MethodGet).Name("export_proposal_votes")
+}
+
+// IdempotencyScopes scopes the keys of the votes by the proposal and the voter of the prepared vote, the votes
+// prepared by another instance or expired ones are scoped by the prepared vote identifier.
+func (h *Proposal) IdempotencyScopes() map[string]IdempotencyScope {
+	return map[string]IdempotencyScope{
+		"proposal_vote": func(_ *http.Request, body []byte) (string, bool) {
+			var req forms.VoteRequest
+			if err := json.Unmarshal(body, &req); err != nil || req.ID == "" {
+				return "", false
+			}
+
+			if prepared, ok := h.preparedVotes.Get(req.ID); ok {
+				return prepared.proposal + idempotencyScopeDelim + prepared.voter, true
+			}
+
+			return req.ID, true
+		},
+	}
+}
+
+func (h *Proposal) Describe() []openapi.Route {
+	return []openapi.Route{
+		{
+			Method:    http.MethodGet,
+			Path:      "/v1/proposals/top",
+			Summary:   "Top proposals",
+			Tags:      []string{tagProposals},
+			Query:     openapi.ParamsOf(forms.GetTop{}),
+			Paginated: true,
+			Response:  []proposal.Proposal{},
+		},
+		{
+			Method:   http.MethodGet,
+			Path:     "/v1/proposals/{id}/results",
+			Summary:  "Proposal results computed for the voting type",
+			Tags:     []string{tagProposals},
+			Response: proposal.Results{},
+		},
+		{
+			Method:    http.MethodGet,
+			Path:      "/v1/proposals/{id}/votes",
+			Summary:   "Proposal votes",
+			Tags:      []string{tagProposals, tagVotes},
+			Query:     openapi.ParamsOf(forms.GetVotes{}),
+			Paginated: true,
+			Headers:   []string{response.HeaderNextCursor, response.HeaderTotalVp, response.HeaderResolvedAddress, response.HeaderResolvedEnsName},
+			Response:  []proposal.Vote{},
+		},
+		{
+			Method:       http.MethodGet,
+			Path:         "/v1/proposals/{id}/votes/export",
+			Summary:      "Export all proposal votes as NDJSON or CSV chosen by the Accept header",
+			Tags:         []string{tagProposals, tagVotes},
+			Headers:      []string{response.HeaderTotalCount, response.HeaderTotalVp},
+			Response:     proposal.VoteExport{},
+			ContentTypes: exportContentTypes,
+		},
+		{
+			Method:   http.MethodPost,
+			Path:     "/v1/proposals/{id}/votes/validate",
+			Summary:  "Validate that the voter is able to vote",
+			Tags:     []string{tagProposals, tagVotes},
+			Body:     forms.ValidateVoteRequest{},
+			Response: proposal.VoteValidation{},
+			Headers:  []string{response.HeaderResolvedAddress, response.HeaderResolvedEnsName},
+		},
+		{
+			Method:   http.MethodPost,
+			Path:     "/v1/proposals/{id}/votes/simulate",
+			Summary:  "Project the results of the proposal with the vote before signing",
+			Tags:     []string{tagProposals, tagVotes},
+			Body:     forms.SimulateVoteRequest{},
+			Response: proposal.VoteSimulation{},
+			Headers:  []string{response.HeaderResolvedAddress, response.HeaderResolvedEnsName},
+		},
+		{
+			Method:   http.MethodPost,
+			Path:     "/v1/proposals/{id}/votes/prepare",
+			Summary:  "Prepare typed data of the vote to sign",
+			Tags:     []string{tagProposals, tagVotes},
+			Body:     forms.PrepareVoteRequest{},
+			Response: proposal.VotePreparation{},
+			Headers:  []string{response.HeaderResolvedAddress, response.HeaderResolvedEnsName},
+		},
+		{
+			Method:   http.MethodPost,
+			Path:     "/v1/proposals/votes",
+			Summary:  "Submit signed vote",
+			Tags:     []string{tagProposals, tagVotes},
+			Body:     forms.VoteRequest{},
+			Response: proposal.SuccessfulVote{},
+		},
+		{
+			Method:   http.MethodGet,
+			Path:     "/v1/proposals/{id}",
+			Summary:  "Proposal by identifier",
+			Tags:     []string{tagProposals},
+			Query:    openapi.ParamsOf(forms.GetByID{}),
+			Response: proposal.Proposal{},
+		},
+		{
+			Method:    http.MethodGet,
+			Path:      "/v1/proposals",
+			Summary:   "Proposal list",
+			Tags:      []string{tagProposals},
+			Query:     openapi.ParamsOf(forms.GetList{}),
+			Paginated: true,
+			Response:  []proposal.Proposal{},
+		},
+	}
+}
+
 func (h *Proposal) getByIDAction(w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
 	id := vars["id"]
 
+	form, verr := forms.NewGetByIDForm().ParseAndValidate(r)
+	if verr != nil {
+		response.HandleError(verr, w)
+
+		return
+	}
+
+	params := form.(*forms.GetByID)
 	resp, err := h.pc.GetByID(r.Context(), &storagepb.ProposalByIDRequest{ProposalId: id})
 	if err != nil {
 		log.Error().Err(err).Fields(map[string]interface{}{
@@ -52,12 +199,15 @@ func (h *Proposal) getByIDAction(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	list := []proposal.Proposal{convertToProposalFromProto(resp.Proposal)}
+	enrichProposalEnsNames(r.Context(), h.ens, list)
+
 	w.WriteHeader(http.StatusOK)
-	_ = json.NewEncoder(w).Encode(convertToProposalFromProto(resp.Proposal))
+	_ = json.NewEncoder(w).Encode(fieldset.Select(list[0], params.Fields))
 }
 
 func (h *Proposal) getListAction(w http.ResponseWriter, r *http.Request) {
-	form, verr := forms.NewGetListForm().ParseAndValidate(r)
+	form, verr := forms.NewGetListForm(h.resolver).ParseAndValidate(r)
 	if verr != nil {
 		response.HandleError(verr, w)
 
@@ -65,30 +215,71 @@ func (h *Proposal) getListAction(w http.ResponseWriter, r *http.Request) {
 	}
 
 	params := form.(*forms.GetList)
-	list, err := h.pc.GetByFilter(r.Context(), &storagepb.ProposalByFilterRequest{
+	onlyActive := params.OnlyActive || params.OnlyActiveStates()
+	req := &storagepb.ProposalByFilterRequest{
 		Dao:         &params.Dao,
 		Category:    &params.Category,
 		Limit:       &params.Limit,
 		Offset:      &params.Offset,
 		Title:       &params.Title,
 		ProposalIds: params.Proposals,
-		OnlyActive:  &params.OnlyActive,
-	})
-	if err != nil {
-		log.Error().Err(err).Fields(params.ConvertToMap()).Msg("get proposal list by filter")
-		response.HandleError(response.ResolveError(err), w)
+		OnlyActive:  &onlyActive,
+	}
 
-		return
+	var (
+		resp  []proposal.Proposal
+		total uint64
+	)
+	if params.PostFiltered() {
+		short := params.Only(shortProposalFields...) && !params.Has(common.IncludeDao) && params.ShortInfoFiltered()
+		if !short && !params.Scoped() {
+			response.HandleError(unscopedProposalFilterError(), w)
+
+			return
+		}
+
+		req.Level = proposalInfoLevel(short)
+		scanned, truncated, err := scanProposals(r.Context(), h.pc, req)
+		if err != nil {
+			log.Error().Err(err).Fields(params.ConvertToMap()).Msg("scan proposal list by filter")
+			response.HandleError(response.ResolveError(err), w)
+
+			return
+		}
+
+		var page []*storagepb.ProposalInfo
+		page, total = filterProposals(params, scanned, time.Now())
+		resp = convertToProposalListFromProto(&storagepb.ProposalByFilterResponse{Proposals: page}, false)
+		if truncated {
+			response.AddTotalTruncatedHeader(w)
+		}
+	} else {
+		short := params.Only(shortProposalFields...) && !params.Has(common.IncludeDao)
+		req.Level = proposalInfoLevel(short)
+		list, err := h.pc.GetByFilter(r.Context(), req)
+		if err != nil {
+			log.Error().Err(err).Fields(params.ConvertToMap()).Msg("get proposal list by filter")
+			response.HandleError(response.ResolveError(err), w)
+
+			return
+		}
+
+		resp, total = convertToProposalListFromProto(list, short), list.GetTotalCount()
 	}
 
-	resp := make([]proposal.Proposal, len(list.GetProposals()))
-	for i, info := range list.GetProposals() {
-		resp[i] = convertToProposalFromProto(info)
+	enrichProposalEnsNames(r.Context(), h.ens, resp)
+	if params.Has(common.IncludeDao) {
+		if err := includeProposalDaos(r.Context(), h.dc, resp); err != nil {
+			log.Error().Err(err).Msg("include daos to proposal list")
+			response.HandleError(response.ResolveError(err), w)
+
+			return
+		}
 	}
 
-	response.AddPaginationHeaders(w, params.Offset, params.Limit, list.TotalCount)
+	response.AddPaginationHeaders(w, r, params.Offset, params.Limit, total)
 
-	_ = json.NewEncoder(w).Encode(resp)
+	_ = json.NewEncoder(w).Encode(fieldset.Select(resp, responseFields(params.Fieldset, params.Include)))
 }
 
 func (h *Proposal) getTopAction(w http.ResponseWriter, r *http.Request) {
@@ -101,10 +292,12 @@ func (h *Proposal) getTopAction(w http.ResponseWriter, r *http.Request) {
 
 	params := form.(*forms.GetTop)
 	top := true
+	short := params.Only(shortProposalFields...) && !params.Has(common.IncludeDao)
 	list, err := h.pc.GetByFilter(r.Context(), &storagepb.ProposalByFilterRequest{
 		Limit:  &params.Limit,
 		Offset: &params.Offset,
 		Top:    &top,
+		Level:  proposalInfoLevel(short),
 	})
 	if err != nil {
 		log.Error().Err(err).Fields(params.ConvertToMap()).Msg("get proposal top by filter")
@@ -113,21 +306,27 @@ func (h *Proposal) getTopAction(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	resp := make([]proposal.Proposal, len(list.GetProposals()))
-	for i, info := range list.GetProposals() {
-		resp[i] = convertToProposalFromProto(info)
+	resp := convertToProposalListFromProto(list, short)
+	enrichProposalEnsNames(r.Context(), h.ens, resp)
+	if params.Has(common.IncludeDao) {
+		if err := includeProposalDaos(r.Context(), h.dc, resp); err != nil {
+			log.Error().Err(err).Msg("include daos to proposal top")
+			response.HandleError(response.ResolveError(err), w)
+
+			return
+		}
 	}
 
-	response.AddPaginationHeaders(w, params.Offset, params.Limit, list.TotalCount)
+	response.AddPaginationHeaders(w, r, params.Offset, params.Limit, list.TotalCount)
 
-	_ = json.NewEncoder(w).Encode(resp)
+	_ = json.NewEncoder(w).Encode(fieldset.Select(resp, responseFields(params.Fieldset, params.Include)))
 }
 
 func (h *Proposal) getVotesAction(w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
 	id := vars["id"]
 
-	form, verr := forms.NewGetVotesForm().ParseAndValidate(r)
+	form, verr := forms.NewGetVotesForm(h.resolver).ParseAndValidate(r)
 	if verr != nil {
 		response.HandleError(verr, w)
 
@@ -135,36 +334,87 @@ func (h *Proposal) getVotesAction(w http.ResponseWriter, r *http.Request) {
 	}
 
 	params := form.(*forms.GetVotes)
-	list, err := h.vc.GetVotes(r.Context(), &storagepb.VotesFilterRequest{
-		ProposalIds:  []string{id},
-		OrderByVoter: &params.Voter,
-		Query:        &params.Query,
-		Limit:        &params.Limit,
-		Offset:       &params.Offset,
-	})
+	addResolvedIdentifierHeaders(w, params.Resolved)
+	var list *storagepb.VotesFilterResponse
+	votes, offset, total, cursor, err := cursorList(params.CursorPagination, func(offset, limit uint64) ([]*storagepb.VoteInfo, uint64, error) {
+		resp, err := h.vc.GetVotes(r.Context(), &storagepb.VotesFilterRequest{
+			ProposalIds:  []string{id},
+			OrderByVoter: &params.Voter,
+			Query:        &params.Query,
+			Limit:        &limit,
+			Offset:       &offset,
+		})
+		if err != nil {
+			return nil, 0, err
+		}
+		list = resp
+
+		return resp.GetVotes(), resp.GetTotalCount(), nil
+	}, proposalVoteCursorKey)
 	if err != nil {
 		log.Error().Err(err).Fields(params.ConvertToMap()).Msg("get proposal votes")
 		response.HandleError(response.ResolveError(err), w)
 
 		return
 	}
-
-	resp := make([]proposal.Vote, len(list.GetVotes()))
-	for i, info := range list.GetVotes() {
+	resp := make([]proposal.Vote, len(votes))
+	for i, info := range votes {
 		resp[i] = convertToProposalVoteFromProto(info)
 	}
+	enrichVoteEnsNames(r.Context(), h.ens, resp)
+
+	if params.Has(common.IncludeDao) {
+		if err := includeVoteDaos(r.Context(), h.dc, resp); err != nil {
+			log.Error().Err(err).Msg("include daos to proposal votes")
+			response.HandleError(response.ResolveError(err), w)
+
+			return
+		}
+	}
+
+	response.AddPaginationHeaders(w, r, offset, params.Limit, total)
+	response.AddNextCursorHeader(w, cursor)
+	response.AddTotalVpHeader(w, list.GetTotalVp())
+
+	_ = json.NewEncoder(w).Encode(fieldset.Select(resp, responseFields(params.Fieldset, params.Include)))
+}
+
+func (h *Proposal) exportVotesAction(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id := vars["id"]
+
+	contentType, ok := negotiateExportContentType(r.Header.Get("Accept"))
+	if !ok {
+		response.HandleError(notAcceptableExportError(), w)
+
+		return
+	}
+
+	resp, err := h.pc.GetByID(r.Context(), &storagepb.ProposalByIDRequest{ProposalId: id})
+	if err != nil {
+		log.Error().Err(err).Str("id", id).Msg("get proposal for votes export")
+		response.HandleError(response.ResolveError(err), w)
+
+		return
+	}
 
-	response.AddPaginationHeaders(w, params.Offset, params.Limit, list.TotalCount)
-	response.AddTotalVpHeader(w, list.TotalVp)
+	info := resp.GetProposal()
+	choices := map[string]votingChoices{
+		id: {votingType: info.GetType(), choices: info.GetChoices()},
+	}
 
-	_ = json.NewEncoder(w).Encode(resp)
+	exportVotes(w, r, h.vc, h.ens, contentType, "votes-"+id, &storagepb.VotesFilterRequest{
+		ProposalIds: []string{id},
+	}, func(context.Context, []*storagepb.VoteInfo) (map[string]votingChoices, error) {
+		return choices, nil
+	})
 }
 
 func (h *Proposal) validateVote(w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
 	proposalID := vars["id"]
 
-	form, verr := forms.NewValidateVoteForm().ParseAndValidate(r)
+	form, verr := forms.NewValidateVoteForm(h.resolver).ParseAndValidate(r)
 	if verr != nil {
 		response.HandleError(verr, w)
 
@@ -172,6 +422,7 @@ func (h *Proposal) validateVote(w http.ResponseWriter, r *http.Request) {
 	}
 
 	params := form.(*forms.ValidateVote)
+	addResolvedIdentifierHeaders(w, params.Resolved)
 	validateResponse, err := h.vc.Validate(r.Context(), &storagepb.ValidateRequest{
 		Voter:    string(params.Voter),
 		Proposal: proposalID,
@@ -208,7 +459,7 @@ func (h *Proposal) prepareVote(w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
 	proposalID := vars["id"]
 
-	form, verr := forms.NewPrepareVoteForm().ParseAndValidate(r)
+	form, verr := forms.NewPrepareVoteForm(h.resolver).ParseAndValidate(r)
 	if verr != nil {
 		response.HandleError(verr, w)
 
@@ -216,6 +467,7 @@ func (h *Proposal) prepareVote(w http.ResponseWriter, r *http.Request) {
 	}
 
 	params := form.(*forms.PrepareVote)
+	addResolvedIdentifierHeaders(w, params.Resolved)
 	prepareResponse, err := h.vc.Prepare(r.Context(), &storagepb.PrepareRequest{
 		Voter:    string(params.Voter),
 		Proposal: proposalID,
@@ -231,6 +483,8 @@ func (h *Proposal) prepareVote(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	h.rememberPreparedVote(prepareResponse.GetId(), proposalID, string(params.Voter), prepareResponse.GetTypedData())
+
 	votePreparation := proposal.VotePreparation{
 		ID:        prepareResponse.GetId(),
 		TypedData: prepareResponse.GetTypedData(),
@@ -248,6 +502,12 @@ func (h *Proposal) vote(w http.ResponseWriter, r *http.Request) {
 	}
 
 	params := form.(*forms.Vote)
+	if verr := h.verifyVoteSignature(params.ID, params.Sig); verr != nil {
+		response.HandleError(verr, w)
+
+		return
+	}
+
 	voteResponse, err := h.vc.Vote(r.Context(), &storagepb.VoteRequest{
 		Id:  params.ID,
 		Sig: params.Sig,
@@ -278,7 +538,7 @@ func convertToProposalVoteFromProto(info *storagepb.VoteInfo) proposal.Vote {
 		Ipfs:         info.GetIpfs(),
 		DaoID:        uuid.MustParse(info.GetDaoId()),
 		ProposalID:   info.GetProposalId(),
-		Voter:        info.GetVoter(),
+		Voter:        address.Checksum(info.GetVoter()),
 		EnsName:      info.GetEnsName(),
 		Created:      info.GetCreated(),
 		Reason:       info.GetReason(),
@@ -290,6 +550,39 @@ func convertToProposalVoteFromProto(info *storagepb.VoteInfo) proposal.Vote {
 	}
 }
 
+// proposalInfoLevel pushes the sparse fieldset down to the storage when only short fields are requested.
+func proposalInfoLevel(short bool) *storagepb.ProposalInfoLevel {
+	if !short {
+		return nil
+	}
+
+	return storagepb.ProposalInfoLevel_PROPOSAL_INFO_LEVEL_SHORT.Enum()
+}
+
+// convertToProposalListFromProto converts full proposals or short ones when the short level was requested.
+func convertToProposalListFromProto(list *storagepb.ProposalByFilterResponse, short bool) []proposal.Proposal {
+	if short {
+		resp := make([]proposal.Proposal, len(list.GetProposalsShort()))
+		for i, info := range list.GetProposalsShort() {
+			resp[i] = proposal.Proposal{
+				ID:      info.GetId(),
+				Title:   info.GetTitle(),
+				State:   info.GetState(),
+				Created: info.GetCreated(),
+			}
+		}
+
+		return resp
+	}
+
+	resp := make([]proposal.Proposal, len(list.GetProposals()))
+	for i, info := range list.GetProposals() {
+		resp[i] = convertToProposalFromProto(info)
+	}
+
+	return resp
+}
+
 func convertToProposalFromProto(info *storagepb.ProposalInfo) proposal.Proposal {
 	daoID, _ := uuid.Parse(info.GetDaoId())
 
@@ -298,7 +591,7 @@ func convertToProposalFromProto(info *storagepb.ProposalInfo) proposal.Proposal
 		CreatedAt:         info.GetCreatedAt().AsTime(),
 		UpdatedAt:         info.GetUpdatedAt().AsTime(),
 		Ipfs:              info.GetIpfs(),
-		Author:            info.GetAuthor(),
+		Author:            address.Checksum(info.GetAuthor()),
 		EnsName:           info.GetEnsName(),
 		Created:           info.GetCreated(),
 		DaoID:             daoID,
@@ -0,0 +1,163 @@
+package handlers
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/goverland-labs/goverland-core-storage/protocol/storagepb"
+
+	"github.com/goverland-labs/goverland-core-web-api/internal/response"
+	forms "github.com/goverland-labs/goverland-core-web-api/internal/rest/form/proposal"
+)
+
+const (
+	// proposalScanPageSize is the number of proposals requested at once when the list is filtered by the API
+	proposalScanPageSize uint64 = 500
+	// maxScannedProposals limits the number of the latest proposals matched by the storage filters the API filters
+	// and orders itself, the totals are exact only when the storage filters match fewer proposals
+	maxScannedProposals uint64 = forms.MaxScannedProposals
+)
+
+// proposalLess orders the proposals by the sort option of the list, the ties keep the storage order. The proposals
+// ending soon are the ones which voting is not finished at now by the end time, the finished ones follow them from
+// the latest ended.
+func proposalLess(sortBy string, now time.Time) func(a, b *storagepb.ProposalInfo) bool {
+	switch sortBy {
+	case forms.SortNewest:
+		return func(a, b *storagepb.ProposalInfo) bool {
+			return a.GetCreated() > b.GetCreated()
+		}
+	case forms.SortEndingSoon:
+		unix := uint64(now.Unix())
+
+		return func(a, b *storagepb.ProposalInfo) bool {
+			aEnded, bEnded := a.GetEnd() < unix, b.GetEnd() < unix
+			switch {
+			case aEnded != bEnded:
+				return bEnded
+			case aEnded:
+				return a.GetEnd() > b.GetEnd()
+			default:
+				return a.GetEnd() < b.GetEnd()
+			}
+		}
+	case forms.SortMostVotes:
+		return func(a, b *storagepb.ProposalInfo) bool {
+			return a.GetVotes() > b.GetVotes()
+		}
+	case forms.SortHighestScores:
+		return func(a, b *storagepb.ProposalInfo) bool {
+			return a.GetScoresTotal() > b.GetScoresTotal()
+		}
+	}
+
+	return nil
+}
+
+// matchProposal reports whether the proposal passes the filters of the list the storage does not support.
+func matchProposal(params *forms.GetList, info *storagepb.ProposalInfo) bool {
+	if len(params.States) > 0 && !params.MatchState(info.GetState()) {
+		return false
+	}
+	if params.Author != "" && !strings.EqualFold(params.Author, info.GetAuthor()) {
+		return false
+	}
+	if len(params.Types) > 0 && !containsString(params.Types, info.GetType()) {
+		return false
+	}
+	if params.ExcludeSpam && info.GetSpam() {
+		return false
+	}
+	if info.GetVotes() < params.MinVotes {
+		return false
+	}
+
+	return inRange(info.GetStart(), params.StartFrom, params.StartTo) &&
+		inRange(info.GetEnd(), params.EndFrom, params.EndTo) &&
+		inRange(info.GetCreated(), params.CreatedFrom, params.CreatedTo)
+}
+
+func inRange(value uint64, from, to *uint64) bool {
+	return (from == nil || value >= *from) && (to == nil || value <= *to)
+}
+
+func containsString(list []string, value string) bool {
+	for _, item := range list {
+		if item == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// filterProposals returns the page of the matched proposals in the requested order and the number of all matches.
+func filterProposals(params *forms.GetList, list []*storagepb.ProposalInfo, now time.Time) ([]*storagepb.ProposalInfo, uint64) {
+	matched := make([]*storagepb.ProposalInfo, 0, len(list))
+	for _, info := range list {
+		if matchProposal(params, info) {
+			matched = append(matched, info)
+		}
+	}
+
+	if less := proposalLess(params.Sort, now); less != nil {
+		sort.SliceStable(matched, func(i, j int) bool {
+			return less(matched[i], matched[j])
+		})
+	}
+
+	total := uint64(len(matched))
+	if params.Offset >= total {
+		return nil, total
+	}
+
+	end := min(params.Offset+params.Limit, total)
+
+	return matched[params.Offset:end], total
+}
+
+// scanProposals pages through the latest maxScannedProposals proposals matched by the storage filters for the API
+// filters, truncated reports that the storage filters match more proposals. The short info of the proposals is
+// returned as the proposal info with the short fields when the request asks for the short level.
+func scanProposals(ctx context.Context, pc storagepb.ProposalClient, req *storagepb.ProposalByFilterRequest) (list []*storagepb.ProposalInfo, truncated bool, err error) {
+	limit, offset := proposalScanPageSize, uint64(0)
+	req.Limit, req.Offset = &limit, &offset
+
+	for {
+		limit = min(proposalScanPageSize, maxScannedProposals-offset)
+		page, err := pc.GetByFilter(ctx, req)
+		if err != nil {
+			return nil, false, err
+		}
+
+		items := page.GetProposals()
+		for _, info := range page.GetProposalsShort() {
+			items = append(items, &storagepb.ProposalInfo{
+				Id:      info.GetId(),
+				Title:   info.GetTitle(),
+				State:   info.GetState(),
+				Created: info.GetCreated(),
+			})
+		}
+
+		list = append(list, items...)
+		offset += uint64(len(items))
+		if len(items) == 0 || offset >= page.GetTotalCount() {
+			return list, false, nil
+		}
+		if offset >= maxScannedProposals {
+			return list, true, nil
+		}
+	}
+}
+
+func unscopedProposalFilterError() response.Error {
+	return response.NewValidationError(map[string]response.ErrorMessage{
+		"dao": response.WrongValueError(
+			"should be set with the filters and orders not supported by the storage, or proposals should be given, " +
+				"unless only id, title, state and created are filtered, ordered by newest and returned",
+		),
+	})
+}
@@ -0,0 +1,131 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/goverland-labs/goverland-core-storage/protocol/storagepb"
+	"google.golang.org/grpc"
+
+	"github.com/goverland-labs/goverland-core-web-api/internal/rest/form/common"
+	forms "github.com/goverland-labs/goverland-core-web-api/internal/rest/form/proposal"
+)
+
+func TestFilterProposals(t *testing.T) {
+	list := []*storagepb.ProposalInfo{
+		{Id: "a", State: "active", Author: "0xabc", Type: "basic", Created: 400, Start: 400, End: 900, Votes: 10, ScoresTotal: 50},
+		{Id: "b", State: "closed", Author: "0xdef", Type: "single-choice", Created: 300, Start: 300, End: 500, Votes: 70, ScoresTotal: 90},
+		{Id: "c", State: "pending", Author: "0xABC", Type: "single-choice", Created: 200, Start: 600, End: 700, Votes: 0, ScoresTotal: 0},
+		{Id: "d", State: "closed", Author: "0xdef", Type: "basic", Created: 100, Start: 100, End: 200, Votes: 40, ScoresTotal: 300, Spam: true},
+	}
+	from, to := uint64(500), uint64(800)
+	now := time.Unix(600, 0)
+
+	for name, tc := range map[string]struct {
+		params    forms.GetList
+		offset    uint64
+		limit     uint64
+		wantIDs   []string
+		wantTotal uint64
+	}{
+		"states":               {params: forms.GetList{States: []string{"pending", "closed"}}, wantIDs: []string{"b", "c", "d"}, wantTotal: 3},
+		"author ignores case":  {params: forms.GetList{Author: "0xAbc"}, wantIDs: []string{"a", "c"}, wantTotal: 2},
+		"types":                {params: forms.GetList{Types: []string{"basic"}}, wantIDs: []string{"a", "d"}, wantTotal: 2},
+		"end range":            {params: forms.GetList{EndFrom: &from, EndTo: &to}, wantIDs: []string{"b", "c"}, wantTotal: 2},
+		"start from":           {params: forms.GetList{StartFrom: &from}, wantIDs: []string{"c"}, wantTotal: 1},
+		"exclude spam":         {params: forms.GetList{ExcludeSpam: true}, wantIDs: []string{"a", "b", "c"}, wantTotal: 3},
+		"min votes":            {params: forms.GetList{MinVotes: 40}, wantIDs: []string{"b", "d"}, wantTotal: 2},
+		"newest":               {params: forms.GetList{Sort: forms.SortNewest}, wantIDs: []string{"a", "b", "c", "d"}, wantTotal: 4},
+		"ending soon":          {params: forms.GetList{Sort: forms.SortEndingSoon}, wantIDs: []string{"c", "a", "b", "d"}, wantTotal: 4},
+		"most votes":           {params: forms.GetList{Sort: forms.SortMostVotes}, wantIDs: []string{"b", "d", "a", "c"}, wantTotal: 4},
+		"highest scores":       {params: forms.GetList{Sort: forms.SortHighestScores}, wantIDs: []string{"d", "b", "a", "c"}, wantTotal: 4},
+		"page of sorted list":  {params: forms.GetList{Sort: forms.SortMostVotes}, offset: 1, limit: 2, wantIDs: []string{"d", "a"}, wantTotal: 4},
+		"offset after the end": {params: forms.GetList{}, offset: 4, wantIDs: nil, wantTotal: 4},
+	} {
+		t.Run(name, func(t *testing.T) {
+			params := tc.params
+			params.Offset, params.Limit = tc.offset, tc.limit
+			if params.Limit == 0 {
+				params.Limit = 10
+			}
+
+			page, total := filterProposals(&params, list, now)
+
+			var ids []string
+			for _, info := range page {
+				ids = append(ids, info.GetId())
+			}
+			if !reflect.DeepEqual(ids, tc.wantIDs) || total != tc.wantTotal {
+				t.Errorf("filterProposals() = %v, %d, want %v, %d", ids, total, tc.wantIDs, tc.wantTotal)
+			}
+		})
+	}
+}
+
+func TestFilterProposals_ClosedStates(t *testing.T) {
+	list := []*storagepb.ProposalInfo{
+		{Id: "a", State: "active"},
+		{Id: "b", State: "succeeded"},
+		{Id: "c", State: "defeated"},
+		{Id: "d", State: "closed"},
+		{Id: "e", State: "pending"},
+	}
+
+	page, total := filterProposals(&forms.GetList{States: []string{"closed"}, Pagination: common.Pagination{Limit: 10}}, list, time.Now())
+
+	var ids []string
+	for _, info := range page {
+		ids = append(ids, info.GetId())
+	}
+	if want := []string{"b", "c", "d"}; !reflect.DeepEqual(ids, want) || total != 3 {
+		t.Errorf("filterProposals() = %v, %d, want %v, 3", ids, total, want)
+	}
+}
+
+type scanProposalClientMock struct {
+	storagepb.ProposalClient
+
+	total uint64
+	calls int
+}
+
+func (m *scanProposalClientMock) GetByFilter(_ context.Context, in *storagepb.ProposalByFilterRequest, _ ...grpc.CallOption) (*storagepb.ProposalByFilterResponse, error) {
+	m.calls++
+	end := min(in.GetOffset()+in.GetLimit(), m.total)
+	resp := &storagepb.ProposalByFilterResponse{TotalCount: m.total}
+	for i := in.GetOffset(); i < end; i++ {
+		resp.ProposalsShort = append(resp.ProposalsShort, &storagepb.ProposalShortInfo{Id: fmt.Sprint(i), State: "closed"})
+	}
+
+	return resp, nil
+}
+
+func TestScanProposals(t *testing.T) {
+	for name, tc := range map[string]struct {
+		total         uint64
+		wantLen       uint64
+		wantTruncated bool
+	}{
+		"all proposals":      {total: 1200, wantLen: 1200},
+		"more than the scan": {total: maxScannedProposals + 1, wantLen: maxScannedProposals, wantTruncated: true},
+	} {
+		t.Run(name, func(t *testing.T) {
+			pc := &scanProposalClientMock{total: tc.total}
+			list, truncated, err := scanProposals(context.Background(), pc, &storagepb.ProposalByFilterRequest{
+				Level: storagepb.ProposalInfoLevel_PROPOSAL_INFO_LEVEL_SHORT.Enum(),
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if uint64(len(list)) != tc.wantLen || truncated != tc.wantTruncated {
+				t.Errorf("scanProposals() = %d proposals, truncated %v, want %d, %v", len(list), truncated, tc.wantLen, tc.wantTruncated)
+			}
+			if list[0].GetState() != "closed" {
+				t.Errorf("short info is not converted: %v", list[0])
+			}
+		})
+	}
+}
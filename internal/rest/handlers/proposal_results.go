@@ -0,0 +1,346 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/goverland-labs/goverland-core-storage/protocol/storagepb"
+	"github.com/rs/zerolog/log"
+
+	"github.com/goverland-labs/goverland-core-web-api/internal/response"
+	"github.com/goverland-labs/goverland-core-web-api/internal/response/errs"
+	forms "github.com/goverland-labs/goverland-core-web-api/internal/rest/form/proposal"
+	"github.com/goverland-labs/goverland-core-web-api/internal/rest/models/proposal"
+	"github.com/goverland-labs/goverland-core-web-api/internal/results"
+)
+
+const (
+	// resultsVotesPageSize is the number of votes requested at once to recompute the results
+	resultsVotesPageSize uint64 = 1000
+	// maxResultsVotes is the max number of votes loaded to recompute the results of the proposal
+	maxResultsVotes uint64 = 20000
+	// resultsVotesCacheTTL keeps the loaded votes for the following results and simulation requests,
+	// a new vote changes the vote count of the proposal and the votes are loaded again
+	resultsVotesCacheTTL  = time.Minute
+	resultsVotesCacheSize = 50
+)
+
+var errTooManyResultsVotes = errors.New("too many votes to recompute the results")
+
+func (h *Proposal) getResultsAction(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id := vars["id"]
+
+	resp, err := h.pc.GetByID(r.Context(), &storagepb.ProposalByIDRequest{ProposalId: id})
+	if err != nil {
+		log.Error().Err(err).Str("id", id).Msg("get proposal for results")
+		response.HandleError(response.ResolveError(err), w)
+
+		return
+	}
+
+	info := resp.GetProposal()
+	p := convertToResultsProposalFromProto(info)
+
+	var res results.Result
+	if results.NeedsVotes(p) {
+		votes, lerr := h.loadResultsVotes(r.Context(), info)
+		if errors.Is(lerr, errTooManyResultsVotes) {
+			response.HandleError(tooManyResultsVotesError(), w)
+
+			return
+		}
+		if lerr != nil {
+			log.Error().Err(lerr).Str("id", id).Msg("get proposal votes for results")
+			response.HandleError(response.ResolveError(lerr), w)
+
+			return
+		}
+
+		res, err = results.ComputeFromVotes(p, votes)
+	} else {
+		res, err = results.Compute(p)
+	}
+	if errors.Is(err, results.ErrUnsupportedType) {
+		response.HandleError(unsupportedVotingTypeError(p.Type), w)
+
+		return
+	}
+	if err != nil {
+		log.Error().Err(err).Str("id", id).Msg("compute proposal results")
+		response.HandleError(response.NewInternalError(), w)
+
+		return
+	}
+
+	_ = json.NewEncoder(w).Encode(convertToResultsFromInternal(info, res))
+}
+
+// simulateVote projects the results of the proposal as if the voter voted with the voting power returned by
+// the validation. The simulated vote replaces the previous vote of the voter.
+func (h *Proposal) simulateVote(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id := vars["id"]
+
+	form, verr := forms.NewSimulateVoteForm(h.resolver).ParseAndValidate(r)
+	if verr != nil {
+		response.HandleError(verr, w)
+
+		return
+	}
+
+	params := form.(*forms.SimulateVote)
+	addResolvedIdentifierHeaders(w, params.Resolved)
+	resp, err := h.pc.GetByID(r.Context(), &storagepb.ProposalByIDRequest{ProposalId: id})
+	if err != nil {
+		log.Error().Err(err).Str("id", id).Msg("get proposal for vote simulation")
+		response.HandleError(response.ResolveError(err), w)
+
+		return
+	}
+
+	info := resp.GetProposal()
+	p := convertToResultsProposalFromProto(info)
+	if !results.ValidChoice(p.Type, len(p.Choices), json.RawMessage(params.Choice)) {
+		response.HandleError(response.NewValidationError(map[string]response.ErrorMessage{
+			"choice": response.WrongValueError(fmt.Sprintf("invalid choice for %s voting", p.Type)),
+		}), w)
+
+		return
+	}
+
+	validation, err := h.vc.Validate(r.Context(), &storagepb.ValidateRequest{
+		Voter:    string(params.Voter),
+		Proposal: id,
+	})
+	if err != nil {
+		log.Error().Err(err).Fields(params.ConvertToMap()).Msg("validate vote for simulation")
+		response.HandleError(response.ResolveError(err), w)
+
+		return
+	}
+
+	vote := results.Vote{
+		Voter:  string(params.Voter),
+		Choice: json.RawMessage(params.Choice),
+		Vp:     validation.GetVotingPower(),
+	}
+
+	var current, projected results.Result
+	switch p.Type {
+	case results.RankedChoice, results.Quadratic:
+		votes, lerr := h.loadResultsVotes(r.Context(), info)
+		if errors.Is(lerr, errTooManyResultsVotes) {
+			response.HandleError(tooManyResultsVotesError(), w)
+
+			return
+		}
+		if lerr != nil {
+			log.Error().Err(lerr).Str("id", id).Msg("get proposal votes for simulation")
+			response.HandleError(response.ResolveError(lerr), w)
+
+			return
+		}
+
+		current, err = results.ComputeFromVotes(p, votes)
+		if err == nil {
+			projected, err = results.ComputeFromVotes(p, replaceVote(votes, vote))
+		}
+	default:
+		var previous *results.Vote
+		if validation.GetVoteStatus().GetVoted() {
+			previous = &results.Vote{
+				Voter:  vote.Voter,
+				Choice: validation.GetVoteStatus().GetChoice().GetValue(),
+				Vp:     vote.Vp,
+			}
+		}
+
+		current, err = results.Compute(p)
+		if err == nil {
+			var applied results.Proposal
+			if applied, err = results.Apply(p, vote, previous); err == nil {
+				projected, err = results.Compute(applied)
+			}
+		}
+	}
+	if errors.Is(err, results.ErrUnsupportedType) {
+		response.HandleError(unsupportedVotingTypeError(p.Type), w)
+
+		return
+	}
+	if err != nil {
+		log.Error().Err(err).Str("id", id).Msg("simulate vote")
+		response.HandleError(response.NewInternalError(), w)
+
+		return
+	}
+
+	var validationError *proposal.VoteValidationError
+	if validation.GetValidationError() != nil {
+		validationError = &proposal.VoteValidationError{
+			Message: validation.GetValidationError().GetMessage(),
+			Code:    validation.GetValidationError().GetCode(),
+		}
+	}
+
+	_ = json.NewEncoder(w).Encode(proposal.VoteSimulation{
+		OK:                  validation.GetOk(),
+		VotingPower:         validation.GetVotingPower(),
+		VoteValidationError: validationError,
+		Voted:               validation.GetVoteStatus().GetVoted(),
+		Current:             convertToResultsFromInternal(info, current),
+		Projected:           convertToResultsFromInternal(info, projected),
+		ReachesQuorum:       !current.QuorumReached && projected.QuorumReached,
+		ChangesWinner:       winnerIndex(current) != winnerIndex(projected),
+	})
+}
+
+// replaceVote returns the votes with the vote instead of the previous vote of the same voter.
+func replaceVote(votes []results.Vote, vote results.Vote) []results.Vote {
+	replaced := make([]results.Vote, 0, len(votes)+1)
+	for _, v := range votes {
+		if !strings.EqualFold(v.Voter, vote.Voter) {
+			replaced = append(replaced, v)
+		}
+	}
+
+	return append(replaced, vote)
+}
+
+func winnerIndex(res results.Result) int {
+	if res.Winner == nil {
+		return 0
+	}
+
+	return res.Winner.Index
+}
+
+func unsupportedVotingTypeError(votingType string) response.Error {
+	return response.NewNotAcceptableError(map[string]response.ErrorMessage{
+		"type": {Code: errs.UnsupportedValue, Message: fmt.Sprintf("voting type %q is not supported", votingType)},
+	})
+}
+
+func tooManyResultsVotesError() response.Error {
+	return response.NewNotAcceptableError(map[string]response.ErrorMessage{
+		"votes": {Code: errs.UnsupportedValue, Message: fmt.Sprintf("the results are not recomputed for more than %d votes", maxResultsVotes)},
+	})
+}
+
+// loadResultsVotes loads all votes of the proposal page by page, the votes are cached by the vote count of
+// the proposal. Proposals with more than maxResultsVotes votes are rejected.
+func (h *Proposal) loadResultsVotes(ctx context.Context, info *storagepb.ProposalInfo) ([]results.Vote, error) {
+	if info.GetVotes() > maxResultsVotes {
+		return nil, errTooManyResultsVotes
+	}
+
+	key := fmt.Sprintf("%s/%d", info.GetId(), info.GetVotes())
+	if votes, ok := h.resultsVotes.Get(key); ok {
+		return votes, nil
+	}
+
+	votes, err := h.fetchResultsVotes(ctx, info.GetId())
+	if err != nil {
+		return nil, err
+	}
+	h.resultsVotes.Set(key, votes)
+
+	return votes, nil
+}
+
+func (h *Proposal) fetchResultsVotes(ctx context.Context, id string) ([]results.Vote, error) {
+	var (
+		votes  []results.Vote
+		offset uint64
+	)
+	for {
+		limit := resultsVotesPageSize
+		list, err := h.vc.GetVotes(ctx, &storagepb.VotesFilterRequest{
+			ProposalIds: []string{id},
+			Limit:       &limit,
+			Offset:      &offset,
+		})
+		if err != nil {
+			return nil, err
+		}
+		// the vote count of the proposal is behind the storage
+		if list.GetTotalCount() > maxResultsVotes {
+			return nil, errTooManyResultsVotes
+		}
+
+		for _, info := range list.GetVotes() {
+			votes = append(votes, results.Vote{
+				Voter:  info.GetVoter(),
+				Choice: info.GetChoice().GetValue(),
+				Vp:     float64(info.GetVp()),
+			})
+		}
+
+		offset += uint64(len(list.GetVotes()))
+		if len(list.GetVotes()) == 0 || offset >= list.GetTotalCount() {
+			return votes, nil
+		}
+	}
+}
+
+func convertToResultsProposalFromProto(info *storagepb.ProposalInfo) results.Proposal {
+	scores := make([]float64, len(info.GetScores()))
+	for i, score := range info.GetScores() {
+		scores[i] = float64(score)
+	}
+
+	return results.Proposal{
+		Type:        info.GetType(),
+		Choices:     info.GetChoices(),
+		Scores:      scores,
+		ScoresTotal: float64(info.GetScoresTotal()),
+		ScoresState: info.GetScoresState(),
+		Quorum:      float64(info.GetQuorum()),
+	}
+}
+
+func convertToResultsFromInternal(info *storagepb.ProposalInfo, res results.Result) proposal.Results {
+	choices := make([]proposal.ChoiceResult, len(res.Choices))
+	for i, choice := range res.Choices {
+		choices[i] = convertToChoiceResultFromInternal(choice)
+	}
+
+	var winner *proposal.ChoiceResult
+	if res.Winner != nil {
+		converted := convertToChoiceResultFromInternal(*res.Winner)
+		winner = &converted
+	}
+
+	return proposal.Results{
+		ProposalID:    info.GetId(),
+		Type:          res.Type,
+		State:         info.GetState(),
+		Choices:       choices,
+		Winner:        winner,
+		Tie:           res.Tie,
+		ScoresTotal:   res.ScoresTotal,
+		Quorum:        res.Quorum,
+		QuorumReached: res.QuorumReached,
+		QuorumMissing: res.QuorumMissing,
+		Margin:        res.Margin,
+		MarginPercent: res.MarginPercent,
+		Rounds:        res.Rounds,
+		Recomputed:    res.Recomputed,
+	}
+}
+
+func convertToChoiceResultFromInternal(choice results.Choice) proposal.ChoiceResult {
+	return proposal.ChoiceResult{
+		Index:   choice.Index,
+		Name:    choice.Name,
+		Score:   choice.Score,
+		Percent: choice.Percent,
+	}
+}
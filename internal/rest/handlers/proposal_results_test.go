@@ -0,0 +1,56 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/goverland-labs/goverland-core-storage/protocol/storagepb"
+	"google.golang.org/grpc"
+
+	"github.com/goverland-labs/goverland-core-web-api/internal/results"
+	"github.com/goverland-labs/goverland-core-web-api/pkg/ttlcache"
+)
+
+type resultsVoteClientMock struct {
+	storagepb.VoteClient
+
+	votes []*storagepb.VoteInfo
+	calls int
+}
+
+func (m *resultsVoteClientMock) GetVotes(_ context.Context, in *storagepb.VotesFilterRequest, _ ...grpc.CallOption) (*storagepb.VotesFilterResponse, error) {
+	m.calls++
+	offset := min(in.GetOffset(), uint64(len(m.votes)))
+	end := min(offset+in.GetLimit(), uint64(len(m.votes)))
+
+	return &storagepb.VotesFilterResponse{Votes: m.votes[offset:end], TotalCount: uint64(len(m.votes))}, nil
+}
+
+func TestLoadResultsVotes(t *testing.T) {
+	vc := &resultsVoteClientMock{votes: []*storagepb.VoteInfo{{Voter: "0x1", Vp: 1}, {Voter: "0x2", Vp: 2}}}
+	h := &Proposal{vc: vc, resultsVotes: ttlcache.New[string, []results.Vote](resultsVotesCacheTTL)}
+	info := &storagepb.ProposalInfo{Id: "p", Votes: 2}
+
+	for i := 0; i < 2; i++ {
+		votes, err := h.loadResultsVotes(context.Background(), info)
+		if err != nil || len(votes) != 2 {
+			t.Fatalf("loadResultsVotes() = %v, %v", votes, err)
+		}
+	}
+	if vc.calls != 1 {
+		t.Errorf("expected the votes to be cached, got %d calls", vc.calls)
+	}
+
+	// a new vote changes the vote count and the votes are loaded again
+	vc.votes = append(vc.votes, &storagepb.VoteInfo{Voter: "0x3", Vp: 3})
+	info.Votes = 3
+	if votes, err := h.loadResultsVotes(context.Background(), info); err != nil || len(votes) != 3 {
+		t.Fatalf("loadResultsVotes() = %v, %v", votes, err)
+	}
+
+	info.Votes = maxResultsVotes + 1
+	if _, err := h.loadResultsVotes(context.Background(), info); !errors.Is(err, errTooManyResultsVotes) {
+		t.Errorf("expected errTooManyResultsVotes, got %v", err)
+	}
+}
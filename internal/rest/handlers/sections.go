@@ -0,0 +1,60 @@
+package handlers
+
+import (
+	"context"
+	"sync"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/goverland-labs/goverland-core-web-api/internal/response"
+	"github.com/goverland-labs/goverland-core-web-api/internal/rest/models/overview"
+)
+
+// section loads one part of a composed document, every section sets its own fields of the result.
+type section[T any] func(ctx context.Context, result *T) error
+
+// loadSections runs the sections concurrently and returns the errors of the failed ones by the section name.
+func loadSections[T any](ctx context.Context, result *T, sections map[string]section[T], fields map[string]interface{}) map[string]response.Error {
+	var (
+		errs = make(map[string]response.Error)
+		mu   sync.Mutex
+		wg   sync.WaitGroup
+	)
+	for name, load := range sections {
+		wg.Add(1)
+		go func(name string, load section[T]) {
+			defer wg.Done()
+
+			err := load(ctx, result)
+			if err == nil {
+				return
+			}
+
+			log.Error().Err(err).Fields(fields).Str("section", name).Msg("load section")
+
+			mu.Lock()
+			errs[name] = response.ResolveError(err)
+			mu.Unlock()
+		}(name, load)
+	}
+	wg.Wait()
+
+	return errs
+}
+
+// convertToSectionErrors returns nil when all sections are loaded.
+func convertToSectionErrors(errs map[string]response.Error) map[string]overview.SectionError {
+	if len(errs) == 0 {
+		return nil
+	}
+
+	converted := make(map[string]overview.SectionError, len(errs))
+	for name, err := range errs {
+		converted[name] = overview.SectionError{
+			Status:  err.GetHTTPStatus(),
+			Message: err.PublicMessage(),
+		}
+	}
+
+	return converted
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/goverland-labs/goverland-core-web-api/internal/response"
 	"github.com/goverland-labs/goverland-core-web-api/internal/rest/models/stats"
+	"github.com/goverland-labs/goverland-core-web-api/internal/rest/openapi"
 )
 
 type Stats struct {
@@ -26,6 +27,18 @@ func (h *Stats) EnrichRoutes(v1, _ *mux.Router) {
 	v1.HandleFunc("/stats/totals", h.getTotals).Methods(http.MethodGet).Name("get_stats_totals")
 }
 
+func (h *Stats) Describe() []openapi.Route {
+	return []openapi.Route{
+		{
+			Method:   http.MethodGet,
+			Path:     "/v1/stats/totals",
+			Summary:  "Total counters",
+			Tags:     []string{tagStats},
+			Response: stats.Totals{},
+		},
+	}
+}
+
 func (h *Stats) getTotals(w http.ResponseWriter, r *http.Request) {
 	var totals, err = h.sc.GetTotals(r.Context(), &storagepb.GetTotalsRequest{})
 	if err != nil {
@@ -4,39 +4,155 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"strings"
 
 	"github.com/gorilla/mux"
 	"github.com/goverland-labs/goverland-core-feed/protocol/feedpb"
 	"github.com/rs/zerolog/log"
 	"google.golang.org/grpc/metadata"
 
+	ihelpers "github.com/goverland-labs/goverland-core-web-api/internal/helpers"
 	"github.com/goverland-labs/goverland-core-web-api/internal/response"
+	"github.com/goverland-labs/goverland-core-web-api/internal/rest/auth"
 	forms "github.com/goverland-labs/goverland-core-web-api/internal/rest/form/subscribe"
-	"github.com/goverland-labs/goverland-core-web-api/internal/rest/helpers"
 	"github.com/goverland-labs/goverland-core-web-api/internal/rest/models/subscribe"
+	"github.com/goverland-labs/goverland-core-web-api/internal/rest/openapi"
 )
 
 type Subscriber struct {
 	subscribers   feedpb.SubscriberClient
 	subscriptions feedpb.SubscriptionClient
+	webhookURLs   *ihelpers.WebhookURLValidator
+	tokens        *auth.Tokens
 }
 
-func NewSubscribeHandler(subscribers feedpb.SubscriberClient, subscriptions feedpb.SubscriptionClient) *Subscriber {
+func NewSubscribeHandler(subscribers feedpb.SubscriberClient, subscriptions feedpb.SubscriptionClient, webhookURLs *ihelpers.WebhookURLValidator, tokens *auth.Tokens) *Subscriber {
 	return &Subscriber{
 		subscribers:   subscribers,
 		subscriptions: subscriptions,
+		webhookURLs:   webhookURLs,
+		tokens:        tokens,
 	}
 }
 
 func (h *Subscriber) EnrichRoutes(v1, _ *mux.Router) {
 	v1.HandleFunc("/subscribe", h.createSubscriberAction).Methods(http.MethodPost).Name("create_subscriber")
-	v1.HandleFunc("/subscribe", h.updateSubscriberAction).Methods(http.MethodPut).Name("update_subscriber")
-	v1.HandleFunc("/subscriptions", h.subscribeOnDaoAction).Methods(http.MethodPost).Name("create_subscription")
-	v1.HandleFunc("/subscriptions", h.unsubscribeOnDaoAction).Methods(http.MethodDelete).Name("delete_subscription")
+	v1.HandleFunc("/subscribe", h.authorized(h.updateSubscriberAction)).Methods(http.MethodPut).Name("update_subscriber")
+	v1.HandleFunc("/subscribe/token", h.refreshAuthorized(h.refreshTokenAction)).Methods(http.MethodPost).Name("refresh_subscriber_token")
+	v1.HandleFunc("/subscribe/token", h.authorized(h.revokeTokenAction)).Methods(http.MethodDelete).Name("revoke_subscriber_token")
+	v1.HandleFunc("/subscriptions", h.authorized(h.subscribeOnDaoAction)).Methods(http.MethodPost).Name("create_subscription")
+	v1.HandleFunc("/subscriptions", h.authorized(h.unsubscribeOnDaoAction)).Methods(http.MethodDelete).Name("delete_subscription")
+	v1.HandleFunc("/subscriptions/bulk", h.authorized(h.bulkSubscribeAction)).Methods(http.MethodPost).Name("bulk_create_subscriptions")
+	v1.HandleFunc("/subscriptions/bulk", h.authorized(h.bulkUnsubscribeAction)).Methods(http.MethodDelete).Name("bulk_delete_subscriptions")
+}
+
+// IdempotencyScopes scopes the keys of the subscriber creation by the webhook URL and the keys of the other
+// routes by the subscriber verified by the token.
+func (h *Subscriber) IdempotencyScopes() map[string]IdempotencyScope {
+	scope := func(authenticate func(http.Header) (auth.Subscriber, error)) IdempotencyScope {
+		return func(r *http.Request, _ []byte) (string, bool) {
+			s, err := authenticate(r.Header)
+			if err != nil {
+				return "", false
+			}
+
+			return s.ID, true
+		}
+	}
+	subscriber := scope(h.tokens.Authenticate)
+
+	return map[string]IdempotencyScope{
+		"create_subscriber": func(_ *http.Request, body []byte) (string, bool) {
+			var req forms.SubscribeRequest
+			if err := json.Unmarshal(body, &req); err != nil || req.WebhookURL == "" {
+				return "", false
+			}
+
+			return strings.TrimSpace(req.WebhookURL), true
+		},
+		"update_subscriber":         subscriber,
+		"refresh_subscriber_token":  scope(h.tokens.AuthenticateRefresh),
+		"revoke_subscriber_token":   subscriber,
+		"create_subscription":       subscriber,
+		"delete_subscription":       subscriber,
+		"bulk_create_subscriptions": subscriber,
+		"bulk_delete_subscriptions": subscriber,
+	}
+}
+
+func (h *Subscriber) Describe() []openapi.Route {
+	return []openapi.Route{
+		{
+			Method:   http.MethodPost,
+			Path:     "/v1/subscribe",
+			Summary:  "Create webhook subscriber",
+			Tags:     []string{tagSubscriber},
+			Body:     forms.SubscribeRequest{},
+			Status:   http.StatusCreated,
+			Response: subscribe.Subscriber{},
+		},
+		{
+			Method:  http.MethodPut,
+			Path:    "/v1/subscribe",
+			Summary: "Update webhook subscriber",
+			Tags:    []string{tagSubscriber},
+			Body:    forms.SubscribeRequest{},
+			Secured: true,
+		},
+		{
+			Method:   http.MethodPost,
+			Path:     "/v1/subscribe/token",
+			Summary:  "Exchange the refresh token or the subscriber ID for new tokens",
+			Tags:     []string{tagSubscriber},
+			Response: subscribe.Token{},
+			Secured:  true,
+		},
+		{
+			Method:  http.MethodDelete,
+			Path:    "/v1/subscribe/token",
+			Summary: "Revoke subscriber token and its refresh token",
+			Tags:    []string{tagSubscriber},
+			Secured: true,
+		},
+		{
+			Method:  http.MethodPost,
+			Path:    "/v1/subscriptions",
+			Summary: "Subscribe on DAO updates",
+			Tags:    []string{tagSubscriber},
+			Body:    forms.SubscribeOnDaoRequest{},
+			Secured: true,
+		},
+		{
+			Method:  http.MethodDelete,
+			Path:    "/v1/subscriptions",
+			Summary: "Unsubscribe from DAO updates",
+			Tags:    []string{tagSubscriber},
+			Body:    forms.UnsubscribeOnDaoRequest{},
+			Secured: true,
+		},
+		{
+			Method:   http.MethodPost,
+			Path:     "/v1/subscriptions/bulk",
+			Summary:  "Subscribe on updates of several DAOs",
+			Tags:     []string{tagSubscriber},
+			Body:     forms.BulkSubscriptionRequest{},
+			Response: subscribe.BulkResult{},
+			Secured:  true,
+		},
+		{
+			Method:   http.MethodDelete,
+			Path:     "/v1/subscriptions/bulk",
+			Summary:  "Unsubscribe from updates of several DAOs",
+			Tags:     []string{tagSubscriber},
+			Body:     forms.BulkSubscriptionRequest{},
+			Response: subscribe.BulkResult{},
+			Secured:  true,
+		},
+	}
 }
 
 func (h *Subscriber) createSubscriberAction(w http.ResponseWriter, r *http.Request) {
-	form, verr := forms.NewSubscribeForm().ParseAndValidate(r)
+	form, verr := forms.NewSubscribeForm(h.webhookURLs).ParseAndValidate(r)
 	if verr != nil {
 		response.HandleError(verr, w)
 
@@ -53,21 +169,95 @@ func (h *Subscriber) createSubscriberAction(w http.ResponseWriter, r *http.Reque
 		return
 	}
 
+	token, err := h.tokens.Issue(resp.GetSubscriberId())
+	if err != nil {
+		log.Error().Err(err).Msg("issue subscriber token")
+
+		response.HandleError(response.NewInternalError(), w)
+
+		return
+	}
+
+	// the token is not replayed to the retries with the same idempotency key, they get the subscriber ID only
+	replay, _ := json.Marshal(subscribe.CreatedSubscriber{SubscriberID: resp.GetSubscriberId()})
+	response.SetReplayBody(w, append(replay, '\n'))
+	w.Header().Set("Cache-Control", "no-store")
 	w.WriteHeader(http.StatusCreated)
-	_ = json.NewEncoder(w).Encode(convertToSubscriberFromProto(resp.GetSubscriberId()))
+	_ = json.NewEncoder(w).Encode(convertToSubscriberFromProto(resp.GetSubscriberId(), token))
+}
+
+// authorized passes the request with the subscriber verified by the access token.
+func (h *Subscriber) authorized(next http.HandlerFunc) http.HandlerFunc {
+	return authenticated(h.tokens.Authenticate, next)
+}
+
+// refreshAuthorized passes the request with the subscriber verified by the refresh token.
+func (h *Subscriber) refreshAuthorized(next http.HandlerFunc) http.HandlerFunc {
+	return authenticated(h.tokens.AuthenticateRefresh, next)
+}
+
+func authenticated(authenticate func(http.Header) (auth.Subscriber, error), next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		subscriber, err := authenticate(r.Header)
+		if err != nil {
+			response.HandleError(response.NewUnauthorizedError(), w)
+
+			return
+		}
+
+		next(w, r.WithContext(auth.WithSubscriber(r.Context(), subscriber)))
+	}
 }
 
 // todo: think about location of this function
-func prepareOutgoingContext(ctx context.Context, header http.Header) context.Context {
-	subscriberID := helpers.ExtractTokenFromHeaders(header)
+func prepareOutgoingContext(ctx context.Context) context.Context {
+	subscriber, _ := auth.SubscriberFromContext(ctx)
 
 	// todo: move key to const
-	md := metadata.New(map[string]string{"subscriber_id": subscriberID})
+	md := metadata.New(map[string]string{"subscriber_id": subscriber.ID})
 	return metadata.NewOutgoingContext(ctx, md)
 }
 
+// refreshTokenAction issues new tokens for the subscriber and revokes the refresh token used for the request. The raw
+// subscriber ID is exchanged for the tokens while SUBSCRIBER_TOKEN_ACCEPT_RAW_ID is set.
+func (h *Subscriber) refreshTokenAction(w http.ResponseWriter, r *http.Request) {
+	subscriber, _ := auth.SubscriberFromContext(r.Context())
+
+	token, err := h.tokens.Issue(subscriber.ID)
+	if err != nil {
+		log.Error().Err(err).Msg("refresh subscriber token")
+
+		response.HandleError(response.NewInternalError(), w)
+
+		return
+	}
+
+	if subscriber.Claims != nil {
+		h.tokens.Revoke(subscriber.Claims)
+	}
+
+	w.Header().Set("Cache-Control", "no-store")
+	_ = json.NewEncoder(w).Encode(convertToTokenFromAuth(token))
+}
+
+// revokeTokenAction revokes the access token of the request and its refresh token, the raw subscriber ID can not
+// be revoked.
+func (h *Subscriber) revokeTokenAction(w http.ResponseWriter, r *http.Request) {
+	subscriber, _ := auth.SubscriberFromContext(r.Context())
+	if subscriber.Claims == nil {
+		response.HandleError(response.NewValidationError(map[string]response.ErrorMessage{
+			"Authorization": response.WrongValueError("should be the token, the subscriber ID can not be revoked"),
+		}), w)
+
+		return
+	}
+	h.tokens.Revoke(subscriber.Claims)
+
+	w.WriteHeader(http.StatusOK)
+}
+
 func (h *Subscriber) updateSubscriberAction(w http.ResponseWriter, r *http.Request) {
-	form, verr := forms.NewSubscribeForm().ParseAndValidate(r)
+	form, verr := forms.NewSubscribeForm(h.webhookURLs).ParseAndValidate(r)
 	if verr != nil {
 		response.HandleError(verr, w)
 
@@ -75,7 +265,7 @@ func (h *Subscriber) updateSubscriberAction(w http.ResponseWriter, r *http.Reque
 	}
 
 	params := form.(*forms.SubscribeForm)
-	_, err := h.subscribers.Update(prepareOutgoingContext(r.Context(), r.Header), &feedpb.UpdateSubscriberRequest{WebhookUrl: params.WebhookURL})
+	_, err := h.subscribers.Update(prepareOutgoingContext(r.Context()), &feedpb.UpdateSubscriberRequest{WebhookUrl: params.WebhookURL})
 	if err != nil {
 		log.Error().Err(err).Msg("update subscriber")
 
@@ -96,7 +286,7 @@ func (h *Subscriber) subscribeOnDaoAction(w http.ResponseWriter, r *http.Request
 	}
 
 	params := form.(*forms.SubscribeOnDaoForm)
-	_, err := h.subscriptions.Subscribe(prepareOutgoingContext(r.Context(), r.Header), &feedpb.SubscribeRequest{
+	_, err := h.subscriptions.Subscribe(prepareOutgoingContext(r.Context()), &feedpb.SubscribeRequest{
 		DaoId: params.DaoID,
 	})
 	if err != nil {
@@ -119,7 +309,7 @@ func (h *Subscriber) unsubscribeOnDaoAction(w http.ResponseWriter, r *http.Reque
 	}
 
 	params := form.(*forms.UnsubscribeOnDaoForm)
-	_, err := h.subscriptions.Unsubscribe(prepareOutgoingContext(r.Context(), r.Header), &feedpb.UnsubscribeRequest{
+	_, err := h.subscriptions.Unsubscribe(prepareOutgoingContext(r.Context()), &feedpb.UnsubscribeRequest{
 		DaoId: params.DaoID,
 	})
 	if err != nil {
@@ -133,8 +323,18 @@ func (h *Subscriber) unsubscribeOnDaoAction(w http.ResponseWriter, r *http.Reque
 	w.WriteHeader(http.StatusOK)
 }
 
-func convertToSubscriberFromProto(subID string) subscribe.Subscriber {
+func convertToSubscriberFromProto(subID string, token auth.Token) subscribe.Subscriber {
 	return subscribe.Subscriber{
 		SubscriberID: subID,
+		Token:        convertToTokenFromAuth(token),
+	}
+}
+
+func convertToTokenFromAuth(token auth.Token) subscribe.Token {
+	return subscribe.Token{
+		Token:            token.Value,
+		ExpiresAt:        token.ExpiresAt,
+		RefreshToken:     token.Refresh,
+		RefreshExpiresAt: token.RefreshExpiresAt,
 	}
 }
@@ -0,0 +1,80 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+
+	"github.com/goverland-labs/goverland-core-feed/protocol/feedpb"
+	"github.com/rs/zerolog/log"
+
+	"github.com/goverland-labs/goverland-core-web-api/internal/response"
+	forms "github.com/goverland-labs/goverland-core-web-api/internal/rest/form/subscribe"
+	"github.com/goverland-labs/goverland-core-web-api/internal/rest/models/subscribe"
+)
+
+// bulkSubscriptionParallelism limits the concurrent calls to core-feed made by a single bulk request.
+const bulkSubscriptionParallelism = 5
+
+func (h *Subscriber) bulkSubscribeAction(w http.ResponseWriter, r *http.Request) {
+	h.bulkAction(w, r, "bulk subscribe", func(ctx context.Context, daoID string) error {
+		_, err := h.subscriptions.Subscribe(ctx, &feedpb.SubscribeRequest{DaoId: daoID})
+
+		return err
+	})
+}
+
+func (h *Subscriber) bulkUnsubscribeAction(w http.ResponseWriter, r *http.Request) {
+	h.bulkAction(w, r, "bulk unsubscribe", func(ctx context.Context, daoID string) error {
+		_, err := h.subscriptions.Unsubscribe(ctx, &feedpb.UnsubscribeRequest{DaoId: daoID})
+
+		return err
+	})
+}
+
+func (h *Subscriber) bulkAction(w http.ResponseWriter, r *http.Request, action string, call func(ctx context.Context, daoID string) error) {
+	form, verr := forms.NewBulkSubscriptionForm().ParseAndValidate(r)
+	if verr != nil {
+		response.HandleError(verr, w)
+
+		return
+	}
+
+	params := form.(*forms.BulkSubscriptionForm)
+	ctx := prepareOutgoingContext(r.Context())
+
+	result := subscribe.BulkResult{Results: make([]subscribe.DaoResult, len(params.DaoIDs))}
+	sem := make(chan struct{}, bulkSubscriptionParallelism)
+
+	var wg sync.WaitGroup
+	for i, daoID := range params.DaoIDs {
+		wg.Add(1)
+		go func(i int, daoID string) {
+			defer wg.Done()
+
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			result.Results[i] = subscribe.DaoResult{DaoID: daoID, Status: http.StatusOK}
+			if err := call(ctx, daoID); err != nil {
+				log.Error().Err(err).Str("dao", daoID).Msg(action)
+
+				resolved := response.ResolveError(err)
+				result.Results[i].Status = resolved.GetHTTPStatus()
+				result.Results[i].Error = resolved.PublicMessage()
+			}
+		}(i, daoID)
+	}
+	wg.Wait()
+
+	for _, item := range result.Results {
+		if item.Error == "" {
+			result.Succeeded++
+		} else {
+			result.Failed++
+		}
+	}
+
+	_ = json.NewEncoder(w).Encode(result)
+}
@@ -0,0 +1,221 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/goverland-labs/goverland-core-storage/protocol/storagepb"
+	"github.com/rs/zerolog/log"
+
+	ihelpers "github.com/goverland-labs/goverland-core-web-api/internal/helpers"
+	"github.com/goverland-labs/goverland-core-web-api/internal/response"
+	forms "github.com/goverland-labs/goverland-core-web-api/internal/rest/form/common"
+	"github.com/goverland-labs/goverland-core-web-api/internal/rest/models/dao"
+	"github.com/goverland-labs/goverland-core-web-api/internal/rest/models/delegate"
+	"github.com/goverland-labs/goverland-core-web-api/internal/rest/models/overview"
+	"github.com/goverland-labs/goverland-core-web-api/internal/rest/models/proposal"
+	"github.com/goverland-labs/goverland-core-web-api/internal/rest/openapi"
+	"github.com/goverland-labs/goverland-core-web-api/pkg/helpers"
+	"github.com/goverland-labs/goverland-core-web-api/pkg/ical"
+)
+
+type User struct {
+	vc             storagepb.VoteClient
+	dc             storagepb.DaoClient
+	pc             storagepb.ProposalClient
+	delegateClient storagepb.DelegateClient
+	ec             storagepb.EnsClient
+	resolver       *ihelpers.IdentifierResolver
+}
+
+func NewUserHandler(vc storagepb.VoteClient, dc storagepb.DaoClient, pc storagepb.ProposalClient, delegateClient storagepb.DelegateClient, ec storagepb.EnsClient, resolver *ihelpers.IdentifierResolver) APIHandler {
+	return &User{
+		vc:             vc,
+		dc:             dc,
+		pc:             pc,
+		delegateClient: delegateClient,
+		ec:             ec,
+		resolver:       resolver,
+	}
+}
+
+func (h *User) EnrichRoutes(v1, _ *mux.Router) {
+	v1.HandleFunc("/user/{address}/profile", withResolvedAddress(h.resolver, h.getProfileAction)).Methods(http.MethodGet).Name("get_user_profile")
+	v1.HandleFunc("/user/{address}/calendar.ics", withResolvedAddress(h.resolver, h.getCalendarAction)).Methods(http.MethodGet).Name("get_user_calendar")
+}
+
+func (h *User) Describe() []openapi.Route {
+	return []openapi.Route{
+		{
+			Method:     http.MethodGet,
+			Path:       "/v1/user/{address}/profile",
+			Summary:    "User profile with votes, participated DAOs and delegations",
+			Tags:       []string{tagUser},
+			PathParams: map[string]string{"address": "User address or ENS name"},
+			Query:      openapi.ParamsOf(forms.GetUserProfile{}),
+			Response:   overview.UserProfile{},
+			Headers:    []string{response.HeaderResolvedAddress, response.HeaderResolvedEnsName},
+		},
+		{
+			Method:       http.MethodGet,
+			Path:         "/v1/user/{address}/calendar.ics",
+			Summary:      "iCalendar with voting windows of active and upcoming proposals of DAOs the user voted in",
+			Tags:         []string{tagUser, tagProposals},
+			PathParams:   map[string]string{"address": "User address or ENS name"},
+			Response:     "",
+			ContentTypes: []string{ical.ContentType},
+			Headers:      []string{response.HeaderResolvedAddress, response.HeaderResolvedEnsName},
+		},
+	}
+}
+
+// getCalendarAction builds the calendar of the DAOs the user participated in.
+func (h *User) getCalendarAction(w http.ResponseWriter, r *http.Request) {
+	resolved := resolvedIdentifier(r)
+	address := resolved.Address
+
+	daos, err := h.vc.GetDaosVotedIn(r.Context(), &storagepb.DaosVotedInRequest{Voter: address})
+	if err != nil {
+		log.Error().Err(err).Str("address", address).Msg("get user participated daos for calendar")
+		response.HandleError(response.ResolveError(err), w)
+
+		return
+	}
+
+	daoIDs := limitCalendarDaos(daos.GetDaoIds())
+	proposals, err := loadDaosCalendarProposals(r.Context(), h.pc, daoIDs)
+	if err != nil {
+		log.Error().Err(err).Str("address", address).Msg("get user calendar proposals")
+		response.HandleError(response.ResolveError(err), w)
+
+		return
+	}
+
+	names, err := calendarDaoNames(r.Context(), h.dc, daoIDs)
+	if err != nil {
+		log.Error().Err(err).Str("address", address).Msg("get user calendar daos")
+		response.HandleError(response.ResolveError(err), w)
+
+		return
+	}
+
+	user := resolved.ENSName
+	if user == "" {
+		user = address
+	}
+
+	writeCalendar(w, newProposalsCalendar(fmt.Sprintf("Proposals of DAOs %s voted in", user), proposals, names))
+}
+
+// getProfileAction resolves the identifier once and loads the sections of the profile in parallel.
+// A failed section is reported in the errors of the document.
+func (h *User) getProfileAction(w http.ResponseWriter, r *http.Request) {
+	resolved := resolvedIdentifier(r)
+	address := resolved.Address
+
+	form, verr := forms.NewGetUserProfileForm().ParseAndValidate(r)
+	if verr != nil {
+		response.HandleError(verr, w)
+
+		return
+	}
+
+	params := form.(*forms.GetUserProfile)
+	sections := map[string]section[overview.UserProfile]{
+		overview.SectionVotes: func(ctx context.Context, result *overview.UserProfile) error {
+			resp, err := h.vc.GetVotes(ctx, &storagepb.VotesFilterRequest{
+				Voter: &address,
+				Limit: &params.Votes,
+			})
+			if err != nil {
+				return err
+			}
+
+			result.VotesCount = helpers.Ptr(resp.GetTotalCount())
+			result.RecentVotes = make([]proposal.Vote, len(resp.GetVotes()))
+			for i, info := range resp.GetVotes() {
+				result.RecentVotes[i] = convertToVoteFromProto(info)
+			}
+
+			return nil
+		},
+		overview.SectionParticipatedDaos: func(ctx context.Context, result *overview.UserProfile) error {
+			resp, err := h.vc.GetDaosVotedIn(ctx, &storagepb.DaosVotedInRequest{Voter: address})
+			if err != nil {
+				return err
+			}
+
+			ids := resp.GetDaoIds()
+			if uint64(len(ids)) > params.Daos {
+				ids = ids[:params.Daos]
+			}
+
+			parsed := make([]uuid.UUID, 0, len(ids))
+			for _, id := range ids {
+				if daoID, err := uuid.Parse(id); err == nil {
+					parsed = append(parsed, daoID)
+				}
+			}
+
+			daos, err := loadCompactDaos(ctx, h.dc, parsed)
+			if err != nil {
+				return err
+			}
+
+			result.ParticipatedDaosCount = helpers.Ptr(uint64(len(resp.GetDaoIds())))
+			result.ParticipatedDaos = make([]*dao.Compact, 0, len(parsed))
+			for _, id := range parsed {
+				if compact, ok := daos[id]; ok {
+					result.ParticipatedDaos = append(result.ParticipatedDaos, compact)
+				}
+			}
+
+			return nil
+		},
+		overview.SectionDelegations: func(ctx context.Context, result *overview.UserProfile) error {
+			resp, err := h.delegateClient.GetDelegationSummary(ctx, &storagepb.GetDelegationSummaryRequest{Address: address})
+			if err != nil {
+				return err
+			}
+
+			result.Delegations = &delegate.TotalDelegations{
+				TotalDelegatorsCount: int(resp.GetTotalDelegatorsCount()),
+				TotalDelegatesCount:  int(resp.GetTotalDelegatesCount()),
+			}
+
+			return nil
+		},
+	}
+
+	result := overview.UserProfile{
+		Address: address,
+		EnsName: resolved.ENSName,
+	}
+	if !resolved.WasENS {
+		sections[overview.SectionEnsName] = func(ctx context.Context, result *overview.UserProfile) error {
+			resp, err := h.ec.GetEnsByAddresses(ctx, &storagepb.EnsByAddressesRequest{Addresses: []string{address}})
+			if err != nil {
+				return err
+			}
+
+			for _, info := range resp.GetEnsNames() {
+				if info.GetName() != "" {
+					result.EnsName = info.GetName()
+
+					break
+				}
+			}
+
+			return nil
+		}
+	}
+
+	errs := loadSections(r.Context(), &result, sections, map[string]interface{}{"address": address})
+	result.Errors = convertToSectionErrors(errs)
+
+	_ = json.NewEncoder(w).Encode(result)
+}
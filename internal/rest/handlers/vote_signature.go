@@ -0,0 +1,79 @@
+package handlers
+
+import (
+	"strings"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/goverland-labs/goverland-core-web-api/internal/response"
+	"github.com/goverland-labs/goverland-core-web-api/pkg/eip712"
+)
+
+// preparedVote is the vote returned by prepareVote, it is kept to verify the signature before sending the vote.
+type preparedVote struct {
+	proposal string
+	voter    string
+	// hash is nil when the typed data can not be hashed
+	hash []byte
+}
+
+// rememberPreparedVote keeps the proposal, the voter and the hash of the typed data to sign, the vote is sent
+// without local verification when the typed data can not be hashed.
+func (h *Proposal) rememberPreparedVote(id, proposal, voter, typedData string) {
+	prepared := preparedVote{proposal: proposal, voter: voter}
+	defer func() {
+		h.preparedVotes.Set(id, prepared)
+	}()
+
+	td, err := eip712.Parse([]byte(typedData))
+	if err != nil {
+		log.Warn().Err(err).Str("id", id).Msg("parse prepared vote typed data")
+
+		return
+	}
+
+	hash, err := td.Hash()
+	if err != nil {
+		log.Warn().Err(err).Str("id", id).Msg("hash prepared vote typed data")
+
+		return
+	}
+	prepared.hash = hash
+}
+
+// verifyVoteSignature rejects malformed signatures and the ones which can not be recovered for the prepared vote. A
+// signature recovered to another address is sent to the relayer as is, since contract wallets (EIP-1271) such as a
+// Safe with a single owner sign with a key of the owner. Votes prepared by another instance or expired ones are not
+// verified.
+func (h *Proposal) verifyVoteSignature(id, sig string) response.Error {
+	prepared, ok := h.preparedVotes.Get(id)
+	if !ok || prepared.hash == nil {
+		return nil
+	}
+
+	signature, err := eip712.ParseSignature(sig)
+	if err != nil {
+		return response.NewValidationError(map[string]response.ErrorMessage{
+			"sig": response.WrongFormatError("should be a hex string"),
+		})
+	}
+	if !eip712.IsECDSA(signature) {
+		return nil
+	}
+
+	signer, err := eip712.RecoverAddress(prepared.hash, signature)
+	if err != nil {
+		return response.NewValidationError(map[string]response.ErrorMessage{
+			"sig": response.WrongValueError("signature can not be recovered"),
+		})
+	}
+	if !strings.EqualFold(signer, prepared.voter) {
+		log.Info().
+			Str("id", id).
+			Str("voter", prepared.voter).
+			Str("signer", signer).
+			Msg("vote signer differs from the voter, leaving the verification to the relayer")
+	}
+
+	return nil
+}
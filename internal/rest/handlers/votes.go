@@ -1,6 +1,7 @@
 package handlers
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 
@@ -11,37 +12,82 @@ import (
 
 	ihelpers "github.com/goverland-labs/goverland-core-web-api/internal/helpers"
 	"github.com/goverland-labs/goverland-core-web-api/internal/response"
+	"github.com/goverland-labs/goverland-core-web-api/internal/rest/fieldset"
 	forms "github.com/goverland-labs/goverland-core-web-api/internal/rest/form/common"
 	"github.com/goverland-labs/goverland-core-web-api/internal/rest/models/proposal"
+	"github.com/goverland-labs/goverland-core-web-api/internal/rest/openapi"
+	"github.com/goverland-labs/goverland-core-web-api/pkg/address"
 )
 
 type Votes struct {
 	vc       storagepb.VoteClient
+	dc       storagepb.DaoClient
+	pc       storagepb.ProposalClient
 	resolver *ihelpers.IdentifierResolver
+	ens      *ihelpers.EnsEnricher
 }
 
-func NewVotesHandler(vc storagepb.VoteClient, resolver *ihelpers.IdentifierResolver) APIHandler {
+func NewVotesHandler(vc storagepb.VoteClient, dc storagepb.DaoClient, pc storagepb.ProposalClient, resolver *ihelpers.IdentifierResolver, ens *ihelpers.EnsEnricher) APIHandler {
 	return &Votes{
 		vc:       vc,
+		dc:       dc,
+		pc:       pc,
 		resolver: resolver,
+		ens:      ens,
 	}
 }
 
 func (h *Votes) EnrichRoutes(v1, _ *mux.Router) {
-	v1.HandleFunc("/user/{address}/votes", h.getUserVotesAction).Methods(http.MethodGet).Name("get_user_votes")
-	v1.HandleFunc("/user/{address}/participated-daos", h.getUserParticipatedDaos).Methods(http.MethodGet).Name("get_user_participated_daos")
+	v1.HandleFunc("/user/{address}/votes", withResolvedAddress(h.resolver, h.getUserVotesAction)).Methods(http.MethodGet).Name("get_user_votes")
+	v1.HandleFunc("/user/{address}/participated-daos", withResolvedAddress(h.resolver, h.getUserParticipatedDaos)).Methods(http.MethodGet).Name("get_user_participated_daos")
 }
 
-func (h *Votes) getUserVotesAction(w http.ResponseWriter, r *http.Request) {
-	vars := mux.Vars(r)
-	resolved, err := h.resolver.Resolve(r.Context(), vars["address"])
-	if err != nil {
-		log.Error().Err(err).Str("identifier", vars["address"]).Msg("resolve identifier for user votes")
-		response.HandleError(response.ResolveError(err), w)
+func (h *Votes) EnrichStreamRoutes(v1 *mux.Router) {
+	v1.HandleFunc("/user/{address}/votes/export", withResolvedAddress(h.resolver, h.exportUserVotesAction)).Methods(http.MethodGet).Name("export_user_votes")
+}
 
-		return
+func (h *Votes) Describe() []openapi.Route {
+	address := map[string]string{"address": "User address or ENS name"}
+
+	return []openapi.Route{
+		{
+			Method:     http.MethodGet,
+			Path:       "/v1/user/{address}/votes",
+			Summary:    "User votes",
+			Tags:       []string{tagUser, tagVotes},
+			PathParams: address,
+			Query:      openapi.ParamsOf(forms.GetUserVotes{}),
+			Paginated:  true,
+			Headers:    []string{response.HeaderNextCursor, response.HeaderTotalVp, response.HeaderResolvedAddress, response.HeaderResolvedEnsName},
+			Response:   []proposal.Vote{},
+		},
+		{
+			Method:       http.MethodGet,
+			Path:         "/v1/user/{address}/votes/export",
+			Summary:      "Export all user votes as NDJSON or CSV chosen by the Accept header",
+			Tags:         []string{tagUser, tagVotes},
+			PathParams:   address,
+			Query:        openapi.ParamsOf(forms.ExportUserVotes{}),
+			Headers:      []string{response.HeaderTotalCount, response.HeaderTotalVp, response.HeaderResolvedAddress, response.HeaderResolvedEnsName},
+			Response:     proposal.VoteExport{},
+			ContentTypes: exportContentTypes,
+		},
+		{
+			Method:     http.MethodGet,
+			Path:       "/v1/user/{address}/participated-daos",
+			Summary:    "Identifiers of DAOs the user voted in",
+			Tags:       []string{tagUser, tagDao},
+			PathParams: address,
+			Query:      openapi.ParamsOf(forms.GetUserParticipatedDaos{}),
+			Paginated:  true,
+			Response:   []uuid.UUID{},
+			Headers:    []string{response.HeaderResolvedAddress, response.HeaderResolvedEnsName},
+		},
 	}
-	address := resolved.Address
+}
+
+func (h *Votes) getUserVotesAction(w http.ResponseWriter, r *http.Request) {
+	address := resolvedAddress(r)
 
 	form, verr := forms.NewGetUserVotesForm().ParseAndValidate(r)
 	if verr != nil {
@@ -51,42 +97,88 @@ func (h *Votes) getUserVotesAction(w http.ResponseWriter, r *http.Request) {
 	}
 
 	params := form.(*forms.GetUserVotes)
-	list, err := h.vc.GetVotes(r.Context(), &storagepb.VotesFilterRequest{
-		ProposalIds: params.Proposals,
-		Voter:       &address,
-		Limit:       &params.Limit,
-		Offset:      &params.Offset,
-		DaoId:       params.DaoID,
-	})
+	var list *storagepb.VotesFilterResponse
+	votes, offset, total, cursor, err := cursorList(params.CursorPagination, func(offset, limit uint64) ([]*storagepb.VoteInfo, uint64, error) {
+		resp, err := h.vc.GetVotes(r.Context(), &storagepb.VotesFilterRequest{
+			ProposalIds: params.Proposals,
+			Voter:       &address,
+			Limit:       &limit,
+			Offset:      &offset,
+			DaoId:       params.DaoID,
+		})
+		if err != nil {
+			return nil, 0, err
+		}
+		list = resp
+
+		return resp.GetVotes(), resp.GetTotalCount(), nil
+	}, userVoteCursorKey)
 	if err != nil {
 		log.Error().Err(err).Fields(params.ConvertToMap()).Msg("get user votes")
 		response.HandleError(response.ResolveError(err), w)
 
 		return
 	}
-
-	resp := make([]proposal.Vote, len(list.GetVotes()))
-	for i, info := range list.GetVotes() {
+	resp := make([]proposal.Vote, len(votes))
+	for i, info := range votes {
 		resp[i] = convertToVoteFromProto(info)
 	}
+	enrichVoteEnsNames(r.Context(), h.ens, resp)
 
-	response.AddPaginationHeaders(w, params.Offset, params.Limit, list.TotalCount)
-	response.AddTotalVpHeader(w, list.TotalVp)
+	if params.Has(forms.IncludeDao) {
+		if err := includeVoteDaos(r.Context(), h.dc, resp); err != nil {
+			log.Error().Err(err).Msg("include daos to user votes")
+			response.HandleError(response.ResolveError(err), w)
 
-	_ = json.NewEncoder(w).Encode(resp)
+			return
+		}
+	}
+
+	response.AddPaginationHeaders(w, r, offset, params.Limit, total)
+	response.AddNextCursorHeader(w, cursor)
+	response.AddTotalVpHeader(w, list.GetTotalVp())
+
+	_ = json.NewEncoder(w).Encode(fieldset.Select(resp, responseFields(params.Fieldset, params.Include)))
+}
+
+func (h *Votes) exportUserVotesAction(w http.ResponseWriter, r *http.Request) {
+	address := resolvedAddress(r)
+
+	contentType, ok := negotiateExportContentType(r.Header.Get("Accept"))
+	if !ok {
+		response.HandleError(notAcceptableExportError(), w)
+
+		return
+	}
+
+	form, verr := forms.NewExportUserVotesForm().ParseAndValidate(r)
+	if verr != nil {
+		response.HandleError(verr, w)
+
+		return
+	}
+
+	params := form.(*forms.ExportUserVotes)
+	exportVotes(w, r, h.vc, h.ens, contentType, "votes-"+address, &storagepb.VotesFilterRequest{
+		ProposalIds: params.Proposals,
+		Voter:       &address,
+		DaoId:       params.DaoID,
+	}, func(ctx context.Context, votes []*storagepb.VoteInfo) (map[string]votingChoices, error) {
+		return loadProposalChoices(ctx, h.pc, votes)
+	})
 }
 
 func (h *Votes) getUserParticipatedDaos(w http.ResponseWriter, r *http.Request) {
-	vars := mux.Vars(r)
-	resolved, err := h.resolver.Resolve(r.Context(), vars["address"])
-	if err != nil {
-		log.Error().Err(err).Str("identifier", vars["address"]).Msg("resolve identifier for participated daos")
-		response.HandleError(response.ResolveError(err), w)
+	address := resolvedAddress(r)
+
+	form, verr := forms.NewGetUserParticipatedDaosForm().ParseAndValidate(r)
+	if verr != nil {
+		response.HandleError(verr, w)
 
 		return
 	}
-	address := resolved.Address
 
+	params := form.(*forms.GetUserParticipatedDaos)
 	list, err := h.vc.GetDaosVotedIn(r.Context(), &storagepb.DaosVotedInRequest{
 		Voter: address,
 	})
@@ -97,13 +189,23 @@ func (h *Votes) getUserParticipatedDaos(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	resp := make([]uuid.UUID, len(list.GetDaoIds()))
-	for i, info := range list.GetDaoIds() {
+	// the storage returns all DAOs at once, so the page is cut here
+	ids := list.GetDaoIds()
+	total := uint64(len(ids))
+	limit := total
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+	start := min(params.Offset, total)
+	end := min(start+limit, total)
+
+	resp := make([]uuid.UUID, 0, end-start)
+	for _, info := range ids[start:end] {
 		id, _ := uuid.Parse(info)
-		resp[i] = id
+		resp = append(resp, id)
 	}
 
-	response.AddPaginationHeaders(w, 0, list.TotalCount, list.TotalCount)
+	response.AddPaginationHeaders(w, r, params.Offset, limit, total)
 	_ = json.NewEncoder(w).Encode(resp)
 }
 
@@ -113,7 +215,7 @@ func convertToVoteFromProto(info *storagepb.VoteInfo) proposal.Vote {
 		Ipfs:         info.GetIpfs(),
 		DaoID:        uuid.MustParse(info.GetDaoId()),
 		ProposalID:   info.GetProposalId(),
-		Voter:        info.GetVoter(),
+		Voter:        address.Checksum(info.GetVoter()),
 		EnsName:      info.GetEnsName(),
 		Created:      info.GetCreated(),
 		Reason:       info.GetReason(),
@@ -0,0 +1,336 @@
+package handlers
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/goverland-labs/goverland-core-storage/protocol/storagepb"
+	"github.com/rs/zerolog/log"
+
+	ihelpers "github.com/goverland-labs/goverland-core-web-api/internal/helpers"
+	"github.com/goverland-labs/goverland-core-web-api/internal/response"
+	"github.com/goverland-labs/goverland-core-web-api/internal/response/errs"
+	"github.com/goverland-labs/goverland-core-web-api/internal/rest/models/proposal"
+	"github.com/goverland-labs/goverland-core-web-api/internal/results"
+	"github.com/goverland-labs/goverland-core-web-api/pkg/address"
+)
+
+const (
+	contentTypeCSV    = "text/csv"
+	contentTypeNDJSON = "application/x-ndjson"
+
+	// exportVotesPageSize is the number of votes requested at once, only one page is kept in memory
+	exportVotesPageSize uint64 = 1000
+	// exportPageWriteTimeout is the time to write a single page, the write deadline of the server is extended by it
+	// before every page, so the export is not limited by REST_WRITE_TIMEOUT
+	exportPageWriteTimeout = 30 * time.Second
+)
+
+// exportContentTypes are the supported formats of the votes export, the first one is used by default
+var exportContentTypes = []string{contentTypeNDJSON, contentTypeCSV}
+
+var voteExportColumns = []string{
+	"id", "proposal_id", "dao_id", "voter", "ens_name", "created", "choice", "choice_label", "vp", "vp_state", "reason", "app",
+}
+
+// votingChoices are used to render the choices of the proposal votes.
+type votingChoices struct {
+	votingType string
+	choices    []string
+}
+
+// choicesLoader returns the voting choices of the proposals of the votes by proposal identifiers.
+type choicesLoader func(ctx context.Context, votes []*storagepb.VoteInfo) (map[string]votingChoices, error)
+
+// negotiateExportContentType picks the export format with the highest quality in the Accept header.
+func negotiateExportContentType(accept string) (string, bool) {
+	if strings.TrimSpace(accept) == "" {
+		return exportContentTypes[0], true
+	}
+
+	var (
+		best    string
+		quality float64
+	)
+	for _, part := range strings.Split(accept, ",") {
+		mediaType, params, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err != nil {
+			continue
+		}
+
+		q := 1.0
+		if value, ok := params["q"]; ok {
+			if q, err = strconv.ParseFloat(value, 64); err != nil {
+				continue
+			}
+		}
+		if q <= quality {
+			continue
+		}
+
+		if contentType, ok := matchExportContentType(mediaType); ok {
+			best, quality = contentType, q
+		}
+	}
+
+	return best, best != ""
+}
+
+func matchExportContentType(mediaType string) (string, bool) {
+	for _, contentType := range exportContentTypes {
+		if mediaType == contentType {
+			return contentType, true
+		}
+	}
+
+	switch mediaType {
+	case "*/*", "application/*":
+		return contentTypeNDJSON, true
+	case "text/*":
+		return contentTypeCSV, true
+	}
+
+	return "", false
+}
+
+func notAcceptableExportError() response.Error {
+	return response.NewNotAcceptableError(map[string]response.ErrorMessage{
+		"Accept": {
+			Code:    errs.UnsupportedValue,
+			Message: fmt.Sprintf("supported formats: %s", strings.Join(exportContentTypes, ", ")),
+		},
+	})
+}
+
+// exportVotes pages through the votes of the filter and streams them flushing every page, the write deadline is
+// extended before every page. The first page is loaded before the response is started, so its errors are reported by
+// the status. Errors of the following pages cut
+// the response short, the client is able to detect it by the X-Total-Count header.
+func exportVotes(
+	w http.ResponseWriter,
+	r *http.Request,
+	vc storagepb.VoteClient,
+	ens *ihelpers.EnsEnricher,
+	contentType string,
+	filename string,
+	filter *storagepb.VotesFilterRequest,
+	loadChoices choicesLoader,
+) {
+	ctx := r.Context()
+	limit, offset := exportVotesPageSize, uint64(0)
+	filter.Limit, filter.Offset = &limit, &offset
+
+	page, choices, err := loadExportPage(ctx, vc, filter, loadChoices)
+	if err != nil {
+		log.Error().Err(err).Str("filename", filename).Msg("get votes for export")
+		response.HandleError(response.ResolveError(err), w)
+
+		return
+	}
+
+	extension := "ndjson"
+	if contentType == contentTypeCSV {
+		extension = "csv"
+	}
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s.%s"`, filename, extension))
+	response.AddTotalCountHeader(w, page.GetTotalCount())
+	response.AddTotalVpHeader(w, page.GetTotalVp())
+
+	enc := newVoteExportEncoder(w, contentType)
+	rc := http.NewResponseController(w)
+	for {
+		// the deadline is not supported by the writers of batch requests, they are limited by the batch itself
+		if err = rc.SetWriteDeadline(time.Now().Add(exportPageWriteTimeout)); err != nil && !errors.Is(err, http.ErrNotSupported) {
+			log.Warn().Err(err).Str("filename", filename).Msg("extend votes export write deadline")
+
+			return
+		}
+
+		votes := convertToVoteExportsFromProto(page.GetVotes(), choices)
+		enrichVoteExportEnsNames(ctx, ens, votes)
+		for i := range votes {
+			if err = enc.Encode(votes[i]); err != nil {
+				log.Warn().Err(err).Str("filename", filename).Msg("write votes export")
+
+				return
+			}
+		}
+		// the response is written at once when the writer does not support flushing, e.g. in batch requests
+		if err = enc.Flush(); err == nil {
+			if err = rc.Flush(); errors.Is(err, http.ErrNotSupported) {
+				err = nil
+			}
+		}
+		if err != nil {
+			log.Warn().Err(err).Str("filename", filename).Msg("flush votes export")
+
+			return
+		}
+
+		offset += uint64(len(page.GetVotes()))
+		if len(page.GetVotes()) == 0 || offset >= page.GetTotalCount() {
+			return
+		}
+
+		page, choices, err = loadExportPage(ctx, vc, filter, loadChoices)
+		if err != nil {
+			log.Error().Err(err).Str("filename", filename).Uint64("offset", offset).Msg("get votes for export")
+
+			return
+		}
+	}
+}
+
+func loadExportPage(ctx context.Context, vc storagepb.VoteClient, filter *storagepb.VotesFilterRequest, loadChoices choicesLoader) (*storagepb.VotesFilterResponse, map[string]votingChoices, error) {
+	page, err := vc.GetVotes(ctx, filter)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	choices, err := loadChoices(ctx, page.GetVotes())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return page, choices, nil
+}
+
+// loadProposalChoices fetches the proposals of the votes in one request, duplicated identifiers are requested once.
+func loadProposalChoices(ctx context.Context, pc storagepb.ProposalClient, votes []*storagepb.VoteInfo) (map[string]votingChoices, error) {
+	ids := make([]string, 0, len(votes))
+	seen := make(map[string]struct{}, len(votes))
+	for _, vote := range votes {
+		if _, ok := seen[vote.GetProposalId()]; ok {
+			continue
+		}
+		seen[vote.GetProposalId()] = struct{}{}
+		ids = append(ids, vote.GetProposalId())
+	}
+
+	result := make(map[string]votingChoices, len(ids))
+	if len(ids) == 0 {
+		return result, nil
+	}
+
+	limit := uint64(len(ids))
+	list, err := pc.GetByFilter(ctx, &storagepb.ProposalByFilterRequest{
+		ProposalIds: ids,
+		Limit:       &limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, info := range list.GetProposals() {
+		result[info.GetId()] = votingChoices{votingType: info.GetType(), choices: info.GetChoices()}
+	}
+
+	return result, nil
+}
+
+func enrichVoteExportEnsNames(ctx context.Context, ens *ihelpers.EnsEnricher, list []proposal.VoteExport) {
+	names := ihelpers.NewEnsNames()
+	for i := range list {
+		names.Add(list[i].Voter, &list[i].EnsName)
+	}
+
+	enrichEnsNames(ctx, ens, names)
+}
+
+func convertToVoteExportsFromProto(list []*storagepb.VoteInfo, choices map[string]votingChoices) []proposal.VoteExport {
+	votes := make([]proposal.VoteExport, len(list))
+	for i, info := range list {
+		voting := choices[info.GetProposalId()]
+		votes[i] = proposal.VoteExport{
+			ID:          info.GetId(),
+			ProposalID:  info.GetProposalId(),
+			DaoID:       info.GetDaoId(),
+			Voter:       address.Checksum(info.GetVoter()),
+			EnsName:     info.GetEnsName(),
+			Created:     info.GetCreated(),
+			Choice:      info.GetChoice().GetValue(),
+			ChoiceLabel: results.ChoiceLabel(voting.votingType, voting.choices, info.GetChoice().GetValue()),
+			Vp:          info.GetVp(),
+			VpState:     info.GetVpState(),
+			Reason:      info.GetReason(),
+			App:         info.GetApp(),
+		}
+	}
+
+	return votes
+}
+
+type voteExportEncoder interface {
+	Encode(vote proposal.VoteExport) error
+	// Flush writes the buffered votes to the response
+	Flush() error
+}
+
+func newVoteExportEncoder(w http.ResponseWriter, contentType string) voteExportEncoder {
+	if contentType == contentTypeCSV {
+		cw := csv.NewWriter(w)
+		// the header is buffered, errors are returned by Flush
+		_ = cw.Write(voteExportColumns)
+
+		return &csvVoteEncoder{w: cw}
+	}
+
+	return &ndjsonVoteEncoder{enc: json.NewEncoder(w)}
+}
+
+type ndjsonVoteEncoder struct {
+	enc *json.Encoder
+}
+
+func (e *ndjsonVoteEncoder) Encode(vote proposal.VoteExport) error {
+	return e.enc.Encode(vote)
+}
+
+func (e *ndjsonVoteEncoder) Flush() error {
+	return nil
+}
+
+type csvVoteEncoder struct {
+	w *csv.Writer
+}
+
+func (e *csvVoteEncoder) Encode(vote proposal.VoteExport) error {
+	return e.w.Write([]string{
+		vote.ID,
+		vote.ProposalID,
+		vote.DaoID,
+		vote.Voter,
+		csvText(vote.EnsName),
+		strconv.FormatUint(vote.Created, 10),
+		string(vote.Choice),
+		csvText(vote.ChoiceLabel),
+		strconv.FormatFloat(float64(vote.Vp), 'f', -1, 32),
+		vote.VpState,
+		csvText(vote.Reason),
+		csvText(vote.App),
+	})
+}
+
+// csvText neutralises the user controlled text which spreadsheets would evaluate as a formula.
+func csvText(value string) string {
+	if value != "" && strings.ContainsRune("=+-@\t\r", rune(value[0])) {
+		return "'" + value
+	}
+
+	return value
+}
+
+func (e *csvVoteEncoder) Flush() error {
+	e.w.Flush()
+
+	return e.w.Error()
+}
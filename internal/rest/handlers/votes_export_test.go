@@ -0,0 +1,61 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"testing"
+
+	"github.com/goverland-labs/goverland-core-web-api/internal/rest/models/proposal"
+)
+
+func TestNegotiateExportContentType(t *testing.T) {
+	for name, tc := range map[string]struct {
+		accept string
+		want   string
+		ok     bool
+	}{
+		"not set":                   {"", contentTypeNDJSON, true},
+		"csv":                       {"text/csv", contentTypeCSV, true},
+		"ndjson":                    {"application/x-ndjson", contentTypeNDJSON, true},
+		"any type":                  {"*/*", contentTypeNDJSON, true},
+		"any text type":             {"text/*", contentTypeCSV, true},
+		"first supported type":      {"application/json, text/csv;charset=utf-8", contentTypeCSV, true},
+		"highest quality":           {"application/x-ndjson;q=0.5, text/csv;q=0.9", contentTypeCSV, true},
+		"equal quality keeps order": {"text/csv, application/x-ndjson", contentTypeCSV, true},
+		"rejected type":             {"text/csv;q=0", "", false},
+		"unsupported type":          {"application/json", "", false},
+	} {
+		t.Run(name, func(t *testing.T) {
+			got, ok := negotiateExportContentType(tc.accept)
+			if got != tc.want || ok != tc.ok {
+				t.Errorf("negotiateExportContentType(%q) = %q, %v, want %q, %v", tc.accept, got, ok, tc.want, tc.ok)
+			}
+		})
+	}
+}
+
+func TestCsvVoteEncoder_Formula(t *testing.T) {
+	var buf bytes.Buffer
+	enc := &csvVoteEncoder{w: csv.NewWriter(&buf)}
+	vote := proposal.VoteExport{
+		ID:          "1",
+		Choice:      json.RawMessage("-1"),
+		ChoiceLabel: "+For",
+		EnsName:     "@aci.eth",
+		Vp:          -2.5,
+		Reason:      `=HYPERLINK("http://evil","x")`,
+		App:         "-snapshot",
+	}
+	if err := enc.Encode(vote); err != nil {
+		t.Fatal(err)
+	}
+	if err := enc.Flush(); err != nil {
+		t.Fatal(err)
+	}
+
+	want := `1,,,,'@aci.eth,0,-1,'+For,-2.5,,"'=HYPERLINK(""http://evil"",""x"")",'-snapshot` + "\n"
+	if got := buf.String(); got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
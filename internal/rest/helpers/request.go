@@ -7,19 +7,16 @@ import (
 
 const (
 	tokenHeader = "Authorization"
-	tokenPrefix = ""
+	tokenPrefix = "Bearer "
 )
 
+// ExtractTokenFromHeaders returns the token from the Authorization header, the Bearer scheme is optional.
 func ExtractTokenFromHeaders(header http.Header) string {
-	value := header.Get(tokenHeader)
+	value := strings.TrimSpace(header.Get(tokenHeader))
 
-	if value == "" {
-		return ""
+	if len(value) >= len(tokenPrefix) && strings.EqualFold(value[:len(tokenPrefix)], tokenPrefix) {
+		return strings.TrimSpace(value[len(tokenPrefix):])
 	}
 
-	if !strings.HasPrefix(value, tokenPrefix) {
-		return ""
-	}
-
-	return strings.TrimPrefix(value, tokenPrefix)
+	return value
 }
@@ -0,0 +1,211 @@
+package rest
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"github.com/goverland-labs/goverland-core-web-api/internal/response"
+	apihandlers "github.com/goverland-labs/goverland-core-web-api/internal/rest/handlers"
+	"github.com/goverland-labs/goverland-core-web-api/pkg/ttlcache"
+)
+
+const (
+	IdempotencyKeyHeader     = "Idempotency-Key"
+	IdempotentReplayedHeader = "Idempotent-Replayed"
+	maxIdempotencyKeyLength  = 255
+	idempotencyScopeDelim    = "\x00"
+)
+
+// idempotentResponse is the first response to the request with the idempotency key, it is pending until
+// the handler returns.
+type idempotentResponse struct {
+	fingerprint [sha256.Size]byte
+	done        bool
+	status      int
+	header      http.Header
+	body        []byte
+}
+
+// idempotency replays the stored response for the retries of mutating requests with the same Idempotency-Key.
+// Keys are scoped by the route and the client the route finds for the request, e.g. the verified subscriber or
+// the voter of the proposal, the requests of the routes without the scope pass through.
+// Responses with 5xx statuses are not stored, so the request can be retried, and responses marked with
+// Cache-Control: no-store, e.g. the issued tokens, are replayed only by the body set with response.SetReplayBody.
+type idempotency struct {
+	mu        sync.Mutex
+	responses *ttlcache.Cache[string, *idempotentResponse]
+	scopes    map[string]apihandlers.IdempotencyScope
+}
+
+func newIdempotency(ttl time.Duration, maxKeys int, scopes map[string]apihandlers.IdempotencyScope) *idempotency {
+	return &idempotency{
+		responses: ttlcache.NewBounded[string, *idempotentResponse](ttl, maxKeys),
+		scopes:    scopes,
+	}
+}
+
+func (i *idempotency) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := r.Header.Get(IdempotencyKeyHeader)
+		route := mux.CurrentRoute(r)
+		if key == "" || route == nil || i.scopes[route.GetName()] == nil || !isMutating(r.Method) {
+			next.ServeHTTP(w, r)
+
+			return
+		}
+
+		if len(key) > maxIdempotencyKeyLength {
+			response.HandleError(response.NewValidationError(map[string]response.ErrorMessage{
+				IdempotencyKeyHeader: response.WrongValueError("should be less or equal than 255 characters"),
+			}), w)
+
+			return
+		}
+
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			response.HandleError(response.NewValidationError(map[string]response.ErrorMessage{
+				response.GeneralErrorKey: response.WrongFormatError("unable to read the request body"),
+			}), w)
+
+			return
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+
+		client, ok := i.scopes[route.GetName()](r, body)
+		if !ok {
+			next.ServeHTTP(w, r)
+
+			return
+		}
+
+		cacheKey := strings.Join([]string{route.GetName(), client, key}, idempotencyScopeDelim)
+		fingerprint := requestFingerprint(r, body)
+
+		stored, started := i.start(cacheKey, fingerprint)
+		switch {
+		case !started && stored.fingerprint != fingerprint:
+			response.HandleError(response.NewConflictError("idempotency key is already used for another request"), w)
+
+			return
+		case !started && !stored.done:
+			response.HandleError(response.NewConflictError("request with the same idempotency key is in progress"), w)
+
+			return
+		case !started:
+			replay(w, stored)
+
+			return
+		}
+
+		// the pending key is released when the handler panics, so the request can be retried
+		completed := false
+		defer func() {
+			if !completed {
+				i.release(cacheKey)
+			}
+		}()
+
+		rec := &idempotentRecorder{recorder: newRecorder()}
+		next.ServeHTTP(rec, r)
+		completed = true
+
+		i.finish(cacheKey, &idempotentResponse{
+			fingerprint: fingerprint,
+			done:        true,
+			status:      rec.status,
+			header:      rec.header.Clone(),
+			body:        rec.body.Bytes(),
+		}, rec.replayBody)
+
+		for name, values := range rec.header {
+			w.Header()[name] = values
+		}
+		w.WriteHeader(rec.status)
+		_, _ = w.Write(rec.body.Bytes())
+	})
+}
+
+// start stores the pending response for the key, the stored one is returned when the key is already used.
+func (i *idempotency) start(key string, fingerprint [sha256.Size]byte) (*idempotentResponse, bool) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	if stored, ok := i.responses.Get(key); ok {
+		return stored, false
+	}
+
+	i.responses.Set(key, &idempotentResponse{fingerprint: fingerprint})
+
+	return nil, true
+}
+
+// finish stores the response for the retries, the response which should not be stored is replaced by the replay
+// body when the handler set it.
+func (i *idempotency) finish(key string, resp *idempotentResponse, replayBody []byte) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	if noStore(resp.header) && replayBody != nil {
+		resp.header.Del("Cache-Control")
+		resp.header.Del("Content-Length")
+		resp.body = replayBody
+	}
+	if resp.status >= http.StatusInternalServerError || noStore(resp.header) {
+		i.responses.Delete(key)
+
+		return
+	}
+
+	i.responses.Set(key, resp)
+}
+
+func (i *idempotency) release(key string) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	i.responses.Delete(key)
+}
+
+// idempotentRecorder collects the response of the idempotent request and the body to replay instead of it.
+type idempotentRecorder struct {
+	*recorder
+	replayBody []byte
+}
+
+func (r *idempotentRecorder) SetReplayBody(body []byte) {
+	r.replayBody = body
+}
+
+func noStore(header http.Header) bool {
+	return strings.Contains(strings.ToLower(header.Get("Cache-Control")), "no-store")
+}
+
+func replay(w http.ResponseWriter, stored *idempotentResponse) {
+	for name, values := range stored.header {
+		w.Header()[name] = values
+	}
+	w.Header().Set(IdempotentReplayedHeader, "true")
+	w.WriteHeader(stored.status)
+	_, _ = w.Write(stored.body)
+}
+
+func requestFingerprint(r *http.Request, body []byte) [sha256.Size]byte {
+	return sha256.Sum256([]byte(strings.Join([]string{r.Method, r.URL.RequestURI(), string(body)}, "\n")))
+}
+
+func isMutating(method string) bool {
+	switch method {
+	case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,245 @@
+package rest
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"github.com/goverland-labs/goverland-core-web-api/internal/response"
+	apihandlers "github.com/goverland-labs/goverland-core-web-api/internal/rest/handlers"
+)
+
+// newIdempotentRouter serves the handler at /v1/subscribe scoped by the X-Client header and at /v1/unscoped.
+func newIdempotentRouter(h http.Handler) http.Handler {
+	router := mux.NewRouter()
+	router.Use(newIdempotency(time.Minute, 10, map[string]apihandlers.IdempotencyScope{
+		"scoped": func(r *http.Request, _ []byte) (string, bool) {
+			client := r.Header.Get("X-Client")
+
+			return client, client != ""
+		},
+	}).Middleware)
+	router.Handle("/v1/subscribe", h).Name("scoped")
+	router.Handle("/v1/unscoped", h).Name("unscoped")
+
+	return router
+}
+
+func TestIdempotency(t *testing.T) {
+	type request struct {
+		method string
+		key    string
+		client string
+		body   string
+	}
+
+	tests := []struct {
+		name         string
+		status       int
+		noStore      bool
+		replayBody   string
+		requests     []request
+		wantStatuses []int
+		wantCalls    int
+		wantReplayed bool
+	}{
+		{
+			name:   "retry is replayed",
+			status: http.StatusCreated,
+			requests: []request{
+				{http.MethodPost, "key", "client", `{"a":1}`},
+				{http.MethodPost, "key", "client", `{"a":1}`},
+			},
+			wantStatuses: []int{http.StatusCreated, http.StatusCreated},
+			wantCalls:    1,
+			wantReplayed: true,
+		},
+		{
+			name:   "same key with another body",
+			status: http.StatusCreated,
+			requests: []request{
+				{http.MethodPost, "key", "client", `{"a":1}`},
+				{http.MethodPost, "key", "client", `{"a":2}`},
+			},
+			wantStatuses: []int{http.StatusCreated, http.StatusConflict},
+			wantCalls:    1,
+		},
+		{
+			name:   "keys are scoped by client",
+			status: http.StatusCreated,
+			requests: []request{
+				{http.MethodPost, "key", "first", `{"a":1}`},
+				{http.MethodPost, "key", "second", `{"a":1}`},
+			},
+			wantStatuses: []int{http.StatusCreated, http.StatusCreated},
+			wantCalls:    2,
+		},
+		{
+			name:   "server errors are not stored",
+			status: http.StatusBadGateway,
+			requests: []request{
+				{http.MethodPost, "key", "client", `{"a":1}`},
+				{http.MethodPost, "key", "client", `{"a":1}`},
+			},
+			wantStatuses: []int{http.StatusBadGateway, http.StatusBadGateway},
+			wantCalls:    2,
+		},
+		{
+			name:   "requests of unknown clients are not deduplicated",
+			status: http.StatusCreated,
+			requests: []request{
+				{http.MethodPost, "key", "", `{"a":1}`},
+				{http.MethodPost, "key", "", `{"a":1}`},
+			},
+			wantStatuses: []int{http.StatusCreated, http.StatusCreated},
+			wantCalls:    2,
+		},
+		{
+			name:    "responses with credentials are not stored",
+			status:  http.StatusCreated,
+			noStore: true,
+			requests: []request{
+				{http.MethodPost, "key", "client", `{"a":1}`},
+				{http.MethodPost, "key", "client", `{"a":1}`},
+			},
+			wantStatuses: []int{http.StatusCreated, http.StatusCreated},
+			wantCalls:    2,
+		},
+		{
+			name:       "replay body of responses with credentials",
+			status:     http.StatusCreated,
+			noStore:    true,
+			replayBody: `{"id":"created"}`,
+			requests: []request{
+				{http.MethodPost, "key", "client", `{"a":1}`},
+				{http.MethodPost, "key", "client", `{"a":1}`},
+			},
+			wantStatuses: []int{http.StatusCreated, http.StatusCreated},
+			wantCalls:    1,
+			wantReplayed: true,
+		},
+		{
+			name:   "reads are not idempotent requests",
+			status: http.StatusOK,
+			requests: []request{
+				{http.MethodGet, "key", "", ""},
+				{http.MethodGet, "key", "", ""},
+			},
+			wantStatuses: []int{http.StatusOK, http.StatusOK},
+			wantCalls:    2,
+		},
+		{
+			name:   "too long key",
+			status: http.StatusCreated,
+			requests: []request{
+				{http.MethodPost, strings.Repeat("k", 256), "client", `{"a":1}`},
+			},
+			wantStatuses: []int{http.StatusBadRequest},
+			wantCalls:    0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			h := newIdempotentRouter(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				calls++
+				w.Header().Set("X-Call", "first")
+				if tt.noStore {
+					w.Header().Set("Cache-Control", "no-store")
+				}
+				if tt.replayBody != "" {
+					response.SetReplayBody(w, []byte(tt.replayBody))
+				}
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(`{"id":"created","token":"secret"}`))
+			}))
+
+			var last *httptest.ResponseRecorder
+			for i, req := range tt.requests {
+				r := httptest.NewRequest(req.method, "/v1/subscribe", strings.NewReader(req.body))
+				r.Header.Set(IdempotencyKeyHeader, req.key)
+				if req.client != "" {
+					r.Header.Set("X-Client", req.client)
+				}
+
+				last = httptest.NewRecorder()
+				h.ServeHTTP(last, r)
+				if last.Code != tt.wantStatuses[i] {
+					t.Fatalf("request %d: status = %d, want %d: %s", i, last.Code, tt.wantStatuses[i], last.Body)
+				}
+			}
+
+			if calls != tt.wantCalls {
+				t.Errorf("calls = %d, want %d", calls, tt.wantCalls)
+			}
+			if replayed := last.Header().Get(IdempotentReplayedHeader) == "true"; replayed != tt.wantReplayed {
+				t.Errorf("replayed = %v, want %v", replayed, tt.wantReplayed)
+			}
+			wantBody := `{"id":"created","token":"secret"}`
+			if tt.replayBody != "" {
+				wantBody = tt.replayBody
+			}
+			if tt.wantReplayed && (last.Header().Get("X-Call") != "first" || last.Body.String() != wantBody) {
+				t.Errorf("replayed response = %v %s", last.Header(), last.Body)
+			}
+		})
+	}
+}
+
+func TestIdempotency_Panic(t *testing.T) {
+	calls := 0
+	h := newIdempotentRouter(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		calls++
+		if calls == 1 {
+			panic("handler failed")
+		}
+		w.WriteHeader(http.StatusCreated)
+	}))
+
+	serve := func() *httptest.ResponseRecorder {
+		r := httptest.NewRequest(http.MethodPost, "/v1/subscribe", strings.NewReader(`{"a":1}`))
+		r.Header.Set(IdempotencyKeyHeader, "key")
+		r.Header.Set("X-Client", "client")
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, r)
+
+		return rec
+	}
+
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Fatal("expected the panic to reach the outer middleware")
+			}
+		}()
+		serve()
+	}()
+
+	if rec := serve(); rec.Code != http.StatusCreated {
+		t.Errorf("retry after the panic: status = %d, want %d", rec.Code, http.StatusCreated)
+	}
+}
+
+func TestIdempotency_UnscopedRoute(t *testing.T) {
+	calls := 0
+	h := newIdempotentRouter(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusCreated)
+	}))
+
+	for i := 0; i < 2; i++ {
+		r := httptest.NewRequest(http.MethodPost, "/v1/unscoped", strings.NewReader(`{"a":1}`))
+		r.Header.Set(IdempotencyKeyHeader, "key")
+		r.Header.Set("X-Client", "client")
+		h.ServeHTTP(httptest.NewRecorder(), r)
+	}
+
+	if calls != 2 {
+		t.Errorf("calls = %d, want 2", calls)
+	}
+}
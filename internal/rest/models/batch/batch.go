@@ -0,0 +1,18 @@
+package batch
+
+import (
+	"encoding/json"
+)
+
+type Response struct {
+	Responses []Item `json:"responses"`
+}
+
+type Item struct {
+	// ID of the sub-request
+	ID      string            `json:"id"`
+	Status  int               `json:"status"`
+	Headers map[string]string `json:"headers,omitempty"`
+	// Body is the JSON body of the response, other bodies are returned as a string
+	Body json.RawMessage `json:"body,omitempty"`
+}
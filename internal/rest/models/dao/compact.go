@@ -0,0 +1,16 @@
+package dao
+
+import (
+	"github.com/google/uuid"
+)
+
+// Compact is the short DAO representation embedded into other objects with `include=dao`.
+type Compact struct {
+	ID       uuid.UUID `json:"id"`
+	Alias    string    `json:"alias"`
+	Name     string    `json:"name"`
+	Avatar   string    `json:"avatar"`
+	Symbol   string    `json:"symbol"`
+	Network  string    `json:"network"`
+	Verified bool      `json:"verified"`
+}
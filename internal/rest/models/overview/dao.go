@@ -0,0 +1,31 @@
+package overview
+
+import (
+	"github.com/goverland-labs/goverland-core-web-api/internal/rest/models/dao"
+	"github.com/goverland-labs/goverland-core-web-api/internal/rest/models/delegate"
+	"github.com/goverland-labs/goverland-core-web-api/internal/rest/models/proposal"
+)
+
+const (
+	SectionDao             = "dao"
+	SectionTokenInfo       = "token_info"
+	SectionActiveProposals = "active_proposals"
+	SectionTopDelegates    = "top_delegates"
+	SectionFeed            = "feed"
+)
+
+// DaoOverview composes the data rendered on the DAO page. Sections failed to load are null
+// and described in Errors by the section name.
+type DaoOverview struct {
+	Dao             *dao.Dao                     `json:"dao"`
+	TokenInfo       *dao.TokenInfo               `json:"token_info"`
+	ActiveProposals []proposal.Proposal          `json:"active_proposals"`
+	TopDelegates    []*delegate.DelegatesWrapper `json:"top_delegates"`
+	Feed            []dao.FeedItem               `json:"feed"`
+	Errors          map[string]SectionError      `json:"errors,omitempty"`
+}
+
+type SectionError struct {
+	Status  int    `json:"status"`
+	Message string `json:"message"`
+}
@@ -0,0 +1,27 @@
+package overview
+
+import (
+	"github.com/goverland-labs/goverland-core-web-api/internal/rest/models/dao"
+	"github.com/goverland-labs/goverland-core-web-api/internal/rest/models/delegate"
+	"github.com/goverland-labs/goverland-core-web-api/internal/rest/models/proposal"
+)
+
+const (
+	SectionEnsName          = "ens_name"
+	SectionVotes            = "votes"
+	SectionParticipatedDaos = "participated_daos"
+	SectionDelegations      = "delegations"
+)
+
+// UserProfile composes the data rendered on the user page. The votes section contains both
+// the votes count and the recent votes.
+type UserProfile struct {
+	Address               string                     `json:"address"`
+	EnsName               string                     `json:"ens_name,omitempty"`
+	VotesCount            *uint64                    `json:"votes_count"`
+	RecentVotes           []proposal.Vote            `json:"recent_votes"`
+	ParticipatedDaosCount *uint64                    `json:"participated_daos_count"`
+	ParticipatedDaos      []*dao.Compact             `json:"participated_daos"`
+	Delegations           *delegate.TotalDelegations `json:"delegations"`
+	Errors                map[string]SectionError    `json:"errors,omitempty"`
+}
@@ -4,6 +4,8 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+
+	"github.com/goverland-labs/goverland-core-web-api/internal/rest/models/dao"
 )
 
 type Choices []string
@@ -68,4 +70,5 @@ type Proposal struct {
 	Votes             uint64         `json:"votes"`
 	Timeline          []TimelineItem `json:"timeline,omitempty"`
 	InitialTokenPrice float64        `json:"initial_token_price"`
+	Dao               *dao.Compact   `json:"dao,omitempty"`
 }
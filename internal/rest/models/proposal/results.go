@@ -0,0 +1,44 @@
+package proposal
+
+type Results struct {
+	ProposalID string         `json:"proposal_id"`
+	Type       string         `json:"type"`
+	State      string         `json:"state"`
+	Choices    []ChoiceResult `json:"choices"`
+	Winner     *ChoiceResult  `json:"winner"`
+	Tie        bool           `json:"tie"`
+	// ScoresTotal is the voting power of all votes
+	ScoresTotal   float64 `json:"scores_total"`
+	Quorum        float64 `json:"quorum"`
+	QuorumReached bool    `json:"quorum_reached"`
+	QuorumMissing float64 `json:"quorum_missing"`
+	// Margin is the difference between the leading choice and the runner-up
+	Margin        float64 `json:"margin"`
+	MarginPercent float64 `json:"margin_percent"`
+	// Rounds contains scores of the instant runoff rounds of ranked-choice proposals
+	Rounds [][]float64 `json:"rounds,omitempty"`
+	// Recomputed is true when the scores are calculated from the votes instead of the stored ones
+	Recomputed bool `json:"recomputed"`
+}
+
+type ChoiceResult struct {
+	// Index is the 1-based index of the choice as used in the votes
+	Index   int     `json:"index"`
+	Name    string  `json:"name"`
+	Score   float64 `json:"score"`
+	Percent float64 `json:"percent"`
+}
+
+type VoteSimulation struct {
+	// OK is false when the voter is not able to vote, the projection is calculated anyway
+	OK                  bool                 `json:"ok"`
+	VotingPower         float64              `json:"voting_power"`
+	VoteValidationError *VoteValidationError `json:"error,omitempty"`
+	// Voted is true when the simulated vote replaces the previous vote of the voter
+	Voted     bool    `json:"voted"`
+	Current   Results `json:"current"`
+	Projected Results `json:"projected"`
+	// ReachesQuorum is true when the vote pushes the proposal over the quorum
+	ReachesQuorum bool `json:"reaches_quorum"`
+	ChangesWinner bool `json:"changes_winner"`
+}
@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 
 	"github.com/google/uuid"
+
+	"github.com/goverland-labs/goverland-core-web-api/internal/rest/models/dao"
 )
 
 type Vote struct {
@@ -20,6 +22,23 @@ type Vote struct {
 	Vp           float32         `json:"vp"`
 	VpByStrategy []float32       `json:"vp_by_strategy"`
 	VpState      string          `json:"vp_state"`
+	Dao          *dao.Compact    `json:"dao,omitempty"`
+}
+
+// VoteExport is the vote in the votes export, ChoiceLabel is the choice rendered by the names of the proposal choices.
+type VoteExport struct {
+	ID          string          `json:"id"`
+	ProposalID  string          `json:"proposal_id"`
+	DaoID       string          `json:"dao_id"`
+	Voter       string          `json:"voter"`
+	EnsName     string          `json:"ens_name"`
+	Created     uint64          `json:"created"`
+	Choice      json.RawMessage `json:"choice"`
+	ChoiceLabel string          `json:"choice_label"`
+	Vp          float32         `json:"vp"`
+	VpState     string          `json:"vp_state"`
+	Reason      string          `json:"reason"`
+	App         string          `json:"app"`
 }
 
 type VoteValidation struct {
@@ -0,0 +1,14 @@
+package subscribe
+
+// BulkResult reports the result of the bulk operation for every requested DAO.
+type BulkResult struct {
+	Succeeded int         `json:"succeeded"`
+	Failed    int         `json:"failed"`
+	Results   []DaoResult `json:"results"`
+}
+
+type DaoResult struct {
+	DaoID  string `json:"dao"`
+	Status int    `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
@@ -1,5 +1,21 @@
 package subscribe
 
+import "time"
+
 type Subscriber struct {
 	SubscriberID string `json:"subscriber_id"`
+	Token
+}
+
+// Token is the access token of the subscriber routes and the refresh token to exchange for the next tokens.
+type Token struct {
+	Token            string    `json:"token"`
+	ExpiresAt        time.Time `json:"expires_at"`
+	RefreshToken     string    `json:"refresh_token"`
+	RefreshExpiresAt time.Time `json:"refresh_expires_at"`
+}
+
+// CreatedSubscriber is replayed to the retries of the subscriber creation, the token is returned only once.
+type CreatedSubscriber struct {
+	SubscriberID string `json:"subscriber_id"`
 }
@@ -0,0 +1,56 @@
+package openapi
+
+import (
+	"encoding/json"
+	"html/template"
+	"net/http"
+)
+
+const (
+	SpecPath = "/openapi.json"
+	DocsPath = "/docs"
+)
+
+var docsTemplate = template.Must(template.New("docs").Parse(`<!DOCTYPE html>
+<html>
+<head>
+  <title>{{ .Title }}</title>
+  <meta charset="utf-8"/>
+  <meta name="viewport" content="width=device-width, initial-scale=1">
+</head>
+<body>
+  <redoc spec-url="{{ .SpecURL }}"></redoc>
+  <script src="{{ .Script.URL }}" integrity="{{ .Script.Integrity }}" crossorigin="anonymous"></script>
+</body>
+</html>
+`))
+
+// SpecHandler serves the document as JSON.
+func SpecHandler(doc *Document) http.HandlerFunc {
+	return func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(doc)
+	}
+}
+
+// DocsScript is the pinned Redoc bundle the docs page loads from the third party origin.
+type DocsScript struct {
+	URL string
+	// Integrity is the subresource integrity hash of the bundle, e.g. sha384-..., the browser refuses to run
+	// the script when the content does not match it
+	Integrity string
+}
+
+// DocsHandler serves the HTML page rendering the document from SpecPath.
+func DocsHandler(title string, script DocsScript) http.HandlerFunc {
+	return func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.WriteHeader(http.StatusOK)
+		_ = docsTemplate.Execute(w, map[string]any{
+			"Title":   title,
+			"SpecURL": SpecPath,
+			"Script":  script,
+		})
+	}
+}
@@ -0,0 +1,388 @@
+package openapi
+
+import (
+	"net/http"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/goverland-labs/goverland-core-web-api/internal/response"
+	"github.com/goverland-labs/goverland-core-web-api/internal/rest/form"
+)
+
+const (
+	contentTypeJSON = "application/json"
+
+	schemaError           = "Error"
+	schemaValidationError = "ValidationError"
+	schemaErrorMessage    = "ErrorMessage"
+
+	securitySubscriber = "subscriber"
+)
+
+var pathParamRegexp = regexp.MustCompile(`{([^}:]+)(?::[^}]*)?}`)
+
+// Route describes a single REST route registered through APIHandler.EnrichRoutes.
+type Route struct {
+	Method  string
+	Path    string
+	Summary string
+	Tags    []string
+
+	// PathParams contains optional descriptions of path variables, all of them are added automatically.
+	PathParams map[string]string
+	Query      []Param
+	// Body is an example value of the JSON request body.
+	Body any
+
+	// Status is the successful status code, http.StatusOK by default.
+	Status int
+	// Response is an example value of the JSON response, nil means empty body.
+	Response any
+	// ContentTypes are the media types of the response negotiated by the Accept header, JSON by default.
+	ContentTypes []string
+	// Paginated adds pagination headers and links to the response, see response.AddPaginationHeaders.
+	Paginated bool
+	// Headers contains names of additional response headers, see response.Header* constants.
+	Headers []string
+	// Secured marks routes requiring the subscriber token in the Authorization header.
+	Secured bool
+}
+
+// JSON reports whether the route responds with JSON only.
+func (r Route) JSON() bool {
+	for _, contentType := range r.ContentTypes {
+		if contentType != contentTypeJSON {
+			return false
+		}
+	}
+
+	return true
+}
+
+type Param struct {
+	Name        string
+	Description string
+	Type        string
+	Required    bool
+	Enum        []string
+	Format      string
+	Default     string
+	Minimum     *int64
+	Maximum     *int64
+}
+
+// ParamsOf describes query params of the form bound by form.BindQuery.
+func ParamsOf(f any) []Param {
+	var params []Param
+	collectParams(reflect.TypeOf(f), &params)
+
+	return params
+}
+
+func collectParams(t reflect.Type, params *[]Param) {
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name := field.Tag.Get(form.TagQuery)
+
+		if field.Anonymous && name == "" && field.Type.Kind() == reflect.Struct {
+			collectParams(field.Type, params)
+
+			continue
+		}
+
+		if name == "" {
+			continue
+		}
+
+		rules := form.ParseRules(field.Tag.Get(form.TagValidate))
+		p := Param{
+			Name:        name,
+			Description: field.Tag.Get(form.TagDoc),
+			Type:        paramType(field.Type),
+			Required:    rules.Required,
+			Enum:        rules.OneOf,
+			Format:      rules.Format,
+			Default:     field.Tag.Get(form.TagDefault),
+			Minimum:     rules.Min,
+			Maximum:     rules.Max,
+		}
+		if p.Type == "array" {
+			p.Description += " (comma separated)"
+		}
+		if p.Minimum == nil && isUnsigned(field.Type) {
+			var zero int64
+			p.Minimum = &zero
+		}
+
+		*params = append(*params, p)
+	}
+}
+
+func paramType(t reflect.Type) string {
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return "boolean"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return "integer"
+	case reflect.Slice:
+		return "array"
+	default:
+		return "string"
+	}
+}
+
+func isUnsigned(t reflect.Type) bool {
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	switch t.Kind() {
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return true
+	default:
+		return false
+	}
+}
+
+func (p Param) schema() *Schema {
+	s := &Schema{Type: p.Type, Format: p.Format, Enum: p.Enum}
+	if p.Type == "array" {
+		s.Items = &Schema{Type: "string", Format: p.Format, Enum: p.Enum}
+		s.Format, s.Enum = "", nil
+	}
+	if p.Default != "" {
+		s.Default = p.Default
+		if p.Type == "integer" {
+			if v, err := strconv.ParseInt(p.Default, 10, 64); err == nil {
+				s.Default = v
+			}
+		}
+	}
+	s.Minimum = toFloat(p.Minimum)
+	s.Maximum = toFloat(p.Maximum)
+
+	return s
+}
+
+func toFloat(v *int64) *float64 {
+	if v == nil {
+		return nil
+	}
+	f := float64(*v)
+
+	return &f
+}
+
+var headerDescriptions = map[string]Header{
+	response.HeaderTotalCount:      {Description: "Total number of items", Schema: &Schema{Type: "integer"}},
+	response.HeaderCurrentOffset:   {Description: "Offset of the current page", Schema: &Schema{Type: "integer"}},
+	response.HeaderLimit:           {Description: "Limit of the current page", Schema: &Schema{Type: "integer"}},
+	response.HeaderTotalVp:         {Description: "Total voting power", Schema: &Schema{Type: "number"}},
+	response.HeaderLink:            {Description: "RFC 8288 links to the first, previous, next and last pages", Schema: &Schema{Type: "string"}},
+	response.HeaderNextCursor:      {Description: "Cursor of the next page, omitted on the last page", Schema: &Schema{Type: "string"}},
+	response.HeaderResolvedAddress: {Description: "Address the ENS name of the request was resolved to, omitted for addresses", Schema: &Schema{Type: "string"}},
+	response.HeaderResolvedEnsName: {Description: "ENS name given in the request, omitted for addresses", Schema: &Schema{Type: "string"}},
+}
+
+// Build generates the document for the given routes.
+func Build(info Info, routes []Route) *Document {
+	registry := newSchemaRegistry()
+	registerErrorSchemas(registry)
+
+	doc := &Document{
+		OpenAPI: Version,
+		Info:    info,
+		Paths:   make(map[string]PathItem),
+		Components: Components{
+			Schemas: registry.schemas,
+			Headers: make(map[string]*Header, len(headerDescriptions)),
+			SecuritySchemes: map[string]*SecurityScheme{
+				securitySubscriber: {
+					Type:         "http",
+					Scheme:       "bearer",
+					BearerFormat: "JWT",
+					Description:  "Subscriber token returned by POST /v1/subscribe and POST /v1/subscribe/token, POST /v1/subscribe/token takes the refresh token",
+				},
+			},
+		},
+	}
+
+	for name, h := range headerDescriptions {
+		h := h
+		doc.Components.Headers[name] = &h
+	}
+
+	for _, route := range routes {
+		path := pathParamRegexp.ReplaceAllString(route.Path, "{$1}")
+		item, ok := doc.Paths[path]
+		if !ok {
+			item = make(PathItem)
+			doc.Paths[path] = item
+		}
+
+		item[strings.ToLower(route.Method)] = buildOperation(registry, route)
+	}
+
+	return doc
+}
+
+func buildOperation(registry *schemaRegistry, route Route) *Operation {
+	op := &Operation{
+		OperationID: operationID(route.Method, route.Path),
+		Summary:     route.Summary,
+		Tags:        route.Tags,
+		Responses:   make(map[string]Response),
+	}
+
+	pathParams := pathParamRegexp.FindAllStringSubmatch(route.Path, -1)
+	for _, match := range pathParams {
+		op.Parameters = append(op.Parameters, Parameter{
+			Name:        match[1],
+			In:          "path",
+			Description: route.PathParams[match[1]],
+			Required:    true,
+			Schema:      &Schema{Type: "string"},
+		})
+	}
+
+	for _, p := range route.Query {
+		op.Parameters = append(op.Parameters, Parameter{
+			Name:        p.Name,
+			In:          "query",
+			Description: p.Description,
+			Required:    p.Required,
+			Schema:      p.schema(),
+		})
+	}
+
+	if route.Body != nil {
+		op.RequestBody = &RequestBody{
+			Required: true,
+			Content: map[string]MediaType{
+				contentTypeJSON: {Schema: registry.schemaFor(reflect.TypeOf(route.Body))},
+			},
+		}
+	}
+
+	status := route.Status
+	if status == 0 {
+		status = http.StatusOK
+	}
+
+	success := Response{Description: http.StatusText(status)}
+	if route.Response != nil {
+		contentTypes := route.ContentTypes
+		if len(contentTypes) == 0 {
+			contentTypes = []string{contentTypeJSON}
+		}
+
+		schema := registry.schemaFor(reflect.TypeOf(route.Response))
+		success.Content = make(map[string]MediaType, len(contentTypes))
+		for _, contentType := range contentTypes {
+			success.Content[contentType] = MediaType{Schema: schema}
+		}
+	}
+
+	headers := route.Headers
+	if route.Paginated {
+		headers = append([]string{response.HeaderTotalCount, response.HeaderCurrentOffset, response.HeaderLimit, response.HeaderLink}, headers...)
+	}
+	if len(headers) > 0 {
+		success.Headers = make(map[string]*Header, len(headers))
+		for _, name := range headers {
+			success.Headers[name] = &Header{Ref: "#/components/headers/" + name}
+		}
+	}
+	op.Responses[strconv.Itoa(status)] = success
+
+	if len(route.Query) > 0 || route.Body != nil {
+		op.Responses[strconv.Itoa(http.StatusBadRequest)] = errorResponse(http.StatusBadRequest, schemaValidationError)
+	}
+	if len(pathParams) > 0 {
+		op.Responses[strconv.Itoa(http.StatusNotFound)] = errorResponse(http.StatusNotFound, schemaError)
+	}
+	if route.Secured {
+		op.Security = []map[string][]string{{securitySubscriber: {}}}
+		op.Responses[strconv.Itoa(http.StatusUnauthorized)] = errorResponse(http.StatusUnauthorized, schemaError)
+		op.Responses[strconv.Itoa(http.StatusForbidden)] = errorResponse(http.StatusForbidden, schemaError)
+	}
+	op.Responses[strconv.Itoa(http.StatusInternalServerError)] = errorResponse(http.StatusInternalServerError, schemaError)
+
+	return op
+}
+
+func errorResponse(status int, schema string) Response {
+	return Response{
+		Description: http.StatusText(status),
+		Content: map[string]MediaType{
+			contentTypeJSON: {Schema: &Schema{Ref: refPrefix + schema}},
+		},
+	}
+}
+
+func registerErrorSchemas(registry *schemaRegistry) {
+	registry.schemas[schemaError] = &Schema{
+		Type: "object",
+		Properties: map[string]*Schema{
+			"message": {Type: "string"},
+		},
+		Required: []string{"message"},
+	}
+
+	registry.schemas[schemaErrorMessage] = &Schema{
+		Type: "object",
+		Properties: map[string]*Schema{
+			"code":    {Type: "integer", Description: "Error code, see internal/response/errs"},
+			"message": {Type: "string"},
+		},
+		Required: []string{"code", "message"},
+	}
+
+	registry.schemas[schemaValidationError] = &Schema{
+		Type: "object",
+		Properties: map[string]*Schema{
+			"message": {Type: "string"},
+			"errors": {
+				Type:                 "object",
+				Description:          "Errors by field name, \"" + response.GeneralErrorKey + "\" is used for the request in general",
+				AdditionalProperties: &Schema{Ref: refPrefix + schemaErrorMessage},
+			},
+		},
+		Required: []string{"message", "errors"},
+	}
+}
+
+// operationID builds a stable identifier like "get_v1_daos_id_delegates".
+func operationID(method, path string) string {
+	path = pathParamRegexp.ReplaceAllString(path, "$1")
+	parts := strings.FieldsFunc(path, func(r rune) bool {
+		return r == '/' || r == '-'
+	})
+
+	return strings.ToLower(method) + "_" + strings.Join(parts, "_")
+}
+
+// Operations returns "METHOD path" pairs of all described operations sorted alphabetically.
+func (d *Document) Operations() []string {
+	list := make([]string, 0, len(d.Paths))
+	for path, item := range d.Paths {
+		for method := range item {
+			list = append(list, strings.ToUpper(method)+" "+path)
+		}
+	}
+	sort.Strings(list)
+
+	return list
+}
@@ -0,0 +1,198 @@
+package openapi
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/goverland-labs/goverland-core-web-api/internal/rest/form"
+)
+
+const refPrefix = "#/components/schemas/"
+
+var (
+	timeType       = reflect.TypeOf(time.Time{})
+	uuidType       = reflect.TypeOf(uuid.UUID{})
+	rawMessageType = reflect.TypeOf(json.RawMessage{})
+)
+
+// schemaRegistry converts go types to schemas following encoding/json rules.
+// Named structs are stored as components and referenced by $ref.
+type schemaRegistry struct {
+	schemas map[string]*Schema
+	names   map[reflect.Type]string
+}
+
+func newSchemaRegistry() *schemaRegistry {
+	return &schemaRegistry{
+		schemas: make(map[string]*Schema),
+		names:   make(map[reflect.Type]string),
+	}
+}
+
+func (r *schemaRegistry) schemaFor(t reflect.Type) *Schema {
+	switch t {
+	case timeType:
+		return &Schema{Type: "string", Format: "date-time"}
+	case uuidType:
+		return &Schema{Type: "string", Format: "uuid"}
+	case rawMessageType:
+		return &Schema{Description: "arbitrary JSON value"}
+	}
+
+	switch t.Kind() {
+	case reflect.Pointer:
+		s := r.schemaFor(t.Elem())
+		if s.Ref != "" {
+			return s
+		}
+		s.Nullable = true
+
+		return s
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		return &Schema{Type: "integer", Format: "int32"}
+	case reflect.Int64, reflect.Uint, reflect.Uint64:
+		return &Schema{Type: "integer", Format: "int64"}
+	case reflect.Float32:
+		return &Schema{Type: "number", Format: "float"}
+	case reflect.Float64:
+		return &Schema{Type: "number", Format: "double"}
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return &Schema{Type: "string", Format: "byte"}
+		}
+
+		return &Schema{Type: "array", Items: r.schemaFor(t.Elem())}
+	case reflect.Map:
+		return &Schema{Type: "object", AdditionalProperties: r.schemaFor(t.Elem())}
+	case reflect.Struct:
+		if t.Name() == "" {
+			return r.structSchema(t)
+		}
+
+		return &Schema{Ref: refPrefix + r.register(t)}
+	default:
+		// interface{} and everything we can not describe precisely
+		return &Schema{}
+	}
+}
+
+func (r *schemaRegistry) register(t reflect.Type) string {
+	if name, ok := r.names[t]; ok {
+		return name
+	}
+
+	name := componentName(t, false)
+	if _, taken := r.schemas[name]; taken {
+		name = componentName(t, true)
+	}
+
+	r.names[t] = name
+	// reserve the name before walking the fields to support recursive types
+	r.schemas[name] = &Schema{}
+	*r.schemas[name] = *r.structSchema(t)
+
+	return name
+}
+
+func (r *schemaRegistry) structSchema(t reflect.Type) *Schema {
+	s := &Schema{
+		Type:       "object",
+		Properties: make(map[string]*Schema),
+	}
+
+	r.collectFields(t, s)
+
+	return s
+}
+
+func (r *schemaRegistry) collectFields(t reflect.Type, s *Schema) {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := f.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+
+		name, opts, _ := strings.Cut(tag, ",")
+		if f.Anonymous && name == "" {
+			ft := f.Type
+			if ft.Kind() == reflect.Pointer {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				r.collectFields(ft, s)
+
+				continue
+			}
+		}
+
+		if !f.IsExported() {
+			continue
+		}
+
+		if name == "" {
+			name = f.Name
+		}
+
+		prop := r.schemaFor(f.Type)
+		required := !strings.Contains(opts, "omitempty") && f.Type.Kind() != reflect.Pointer
+		if tag, ok := f.Tag.Lookup(form.TagValidate); ok {
+			rules := form.ParseRules(tag)
+			applyRules(prop, rules)
+			// validated fields may be omitted unless the value is required
+			required = rules.Required
+		}
+
+		s.Properties[name] = prop
+		if required {
+			s.Required = append(s.Required, name)
+		}
+	}
+}
+
+func applyRules(s *Schema, rules form.Rules) {
+	target := s
+	if s.Type == "array" && s.Items != nil && s.Items.Type == "string" {
+		target = s.Items
+	}
+
+	target.Enum = rules.OneOf
+	switch rules.Format {
+	case "url":
+		target.Format = "uri"
+	case "json":
+	case "":
+	default:
+		target.Format = rules.Format
+	}
+	target.Minimum = toFloat(rules.Min)
+	target.Maximum = toFloat(rules.Max)
+}
+
+// componentName returns "<package>.<Type>", or the package path relative to the
+// module internals when the short name is already used by another type.
+func componentName(t reflect.Type, qualified bool) string {
+	pkg := t.PkgPath()
+	if qualified {
+		if idx := strings.Index(pkg, "/internal/"); idx >= 0 {
+			pkg = pkg[idx+len("/internal/"):]
+		}
+		pkg = strings.ReplaceAll(pkg, "/", ".")
+	} else if idx := strings.LastIndex(pkg, "/"); idx >= 0 {
+		pkg = pkg[idx+1:]
+	}
+
+	if pkg == "" {
+		return t.Name()
+	}
+
+	return pkg + "." + t.Name()
+}
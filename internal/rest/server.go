@@ -9,12 +9,24 @@ import (
 	"github.com/goverland-labs/goverland-core-web-api/internal/response"
 
 	apihandlers "github.com/goverland-labs/goverland-core-web-api/internal/rest/handlers"
+	"github.com/goverland-labs/goverland-core-web-api/internal/rest/openapi"
 
 	"github.com/goverland-labs/goverland-core-web-api/internal/config"
 	"github.com/goverland-labs/goverland-core-web-api/pkg/middleware"
 )
 
-func NewRestServer(cfg config.REST, apiHandlers []apihandlers.APIHandler) *http.Server {
+const apiTitle = "Goverland Core WEB API"
+
+func NewRestServer(cfg config.REST, apiHandlers []apihandlers.APIHandler, gateway http.Handler) *http.Server {
+	return &http.Server{
+		Addr:         cfg.Listen,
+		Handler:      configureCorsHandler(newRouter(cfg, apiHandlers, gateway)),
+		WriteTimeout: cfg.WriteTimeout,
+		ReadTimeout:  cfg.ReadTimeout,
+	}
+}
+
+func newRouter(cfg config.REST, apiHandlers []apihandlers.APIHandler, gateway http.Handler) *mux.Router {
 	handler := mux.NewRouter()
 	handler.Use(
 		middleware.Panic,
@@ -22,22 +34,60 @@ func NewRestServer(cfg config.REST, apiHandlers []apihandlers.APIHandler) *http.
 		middleware.ResponseFormatter,
 	)
 
+	// responses of the requests with the same idempotency key are shared by v1, v2 and batch routes
+	scopes := make(map[string]apihandlers.IdempotencyScope)
+	for _, h := range apiHandlers {
+		if ih, ok := h.(apihandlers.IdempotentHandler); ok {
+			for name, scope := range ih.IdempotencyScopes() {
+				scopes[name] = scope
+			}
+		}
+	}
+	idempotency := newIdempotency(cfg.IdempotencyTTL, cfg.IdempotencyMaxKeys, scopes)
+
+	// streamed responses are flushed as they are written, so the routes are matched before the buffered ones
+	streamV1Router := handler.PathPrefix("/v1").Subrouter()
+
 	baseV1Router := handler.PathPrefix("/v1").Subrouter()
-	baseV1Router.Use(middleware.Timeout(cfg.HandleTimeout))
+	baseV1Router.Use(middleware.Timeout(cfg.HandleTimeout), idempotency.Middleware)
 
 	baseV2Router := handler.PathPrefix("/v2").Subrouter()
-	baseV2Router.Use(middleware.Timeout(cfg.HandleTimeout))
+	baseV2Router.Use(middleware.Timeout(cfg.HandleTimeout), idempotency.Middleware)
 
+	var routes []openapi.Route
 	for _, h := range apiHandlers {
 		h.EnrichRoutes(baseV1Router, baseV2Router)
+		if sh, ok := h.(apihandlers.StreamHandler); ok {
+			sh.EnrichStreamRoutes(streamV1Router)
+		}
+		routes = append(routes, h.Describe()...)
 	}
 
-	return &http.Server{
-		Addr:         cfg.Listen,
-		Handler:      configureCorsHandler(handler),
-		WriteTimeout: cfg.WriteTimeout,
-		ReadTimeout:  cfg.ReadTimeout,
+	// batch is not wrapped by the handle timeout, it has its own deadline shared with the sub-requests
+	// streamed and non-JSON responses are not embedded into the batch response
+	unbatched := make(map[string]bool)
+	for _, route := range routes {
+		if !route.JSON() {
+			unbatched[route.Method+" "+route.Path] = true
+		}
+	}
+	handler.Handle(BatchPath, idempotency.Middleware(newBatchHandler(handler, unbatched, cfg.BatchMaxRequests, cfg.BatchTimeout))).
+		Methods(http.MethodPost).Name("batch")
+	routes = append(routes, batchRoute())
+
+	spec := openapi.Build(openapi.Info{Title: apiTitle, Version: cfg.APIVersion}, routes)
+	handler.HandleFunc(openapi.SpecPath, openapi.SpecHandler(spec)).Methods(http.MethodGet).Name("openapi_spec")
+	// the docs page runs the third party script on our origin, so it is served only when the script is pinned by the hash
+	if cfg.DocsScriptIntegrity != "" {
+		script := openapi.DocsScript{URL: cfg.DocsScriptURL, Integrity: cfg.DocsScriptIntegrity}
+		handler.HandleFunc(openapi.DocsPath, openapi.DocsHandler(apiTitle, script)).Methods(http.MethodGet).Name("openapi_docs")
 	}
+
+	gatewayRouter := handler.PathPrefix(GatewayPathPrefix).Subrouter()
+	gatewayRouter.Use(middleware.Timeout(cfg.HandleTimeout))
+	gatewayRouter.PathPrefix("/").Handler(gateway).Name("grpc_gateway")
+
+	return handler
 }
 
 func configureCorsHandler(router *mux.Router) http.Handler {
@@ -46,11 +96,18 @@ func configureCorsHandler(router *mux.Router) http.Handler {
 	handlerAllowedHeaders := handlers.AllowedHeaders([]string{
 		"Content-Type",
 		"Authorization",
+		IdempotencyKeyHeader,
 	})
 	handlerExposedHeaders := handlers.ExposedHeaders([]string{
 		response.HeaderTotalCount,
 		response.HeaderCurrentOffset,
 		response.HeaderLimit,
+		response.HeaderNextCursor,
+		response.HeaderLink,
+		response.HeaderTotalTruncated,
+		response.HeaderResolvedAddress,
+		response.HeaderResolvedEnsName,
+		IdempotentReplayedHeader,
 	})
 	allowedOrigins := handlers.AllowedOrigins([]string{"*"})
 
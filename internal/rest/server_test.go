@@ -0,0 +1,1317 @@
+package rest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/goverland-labs/goverland-core-feed/protocol/feedpb"
+	"github.com/goverland-labs/goverland-core-storage/protocol/storagepb"
+	"go.openly.dev/pointy"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/goverland-labs/goverland-core-web-api/internal/config"
+	ingrpc "github.com/goverland-labs/goverland-core-web-api/internal/grpc"
+	ihelpers "github.com/goverland-labs/goverland-core-web-api/internal/helpers"
+	"github.com/goverland-labs/goverland-core-web-api/internal/response"
+	"github.com/goverland-labs/goverland-core-web-api/internal/rest/auth"
+	proposalforms "github.com/goverland-labs/goverland-core-web-api/internal/rest/form/proposal"
+	subscribeforms "github.com/goverland-labs/goverland-core-web-api/internal/rest/form/subscribe"
+	apihandlers "github.com/goverland-labs/goverland-core-web-api/internal/rest/handlers"
+	"github.com/goverland-labs/goverland-core-web-api/internal/rest/openapi"
+)
+
+var update = flag.Bool("update", false, "rewrite contract golden files")
+
+const (
+	testDaoID      = "2bc3e1d4-6a52-4d4a-9d39-9b37a4dcb0a1"
+	testProposalID = "0x6e2ed5f1a0b15b1f5cb4f3b2f1d0f0f38a4b7c61e1b2a3d4c5e6f708192a3b4c"
+
+	testPublicBaseURL = "https://api.example.com"
+)
+
+type daoClientMock struct {
+	storagepb.DaoClient
+}
+
+func (m *daoClientMock) GetByID(_ context.Context, in *storagepb.DaoByIDRequest, _ ...grpc.CallOption) (*storagepb.DaoByIDResponse, error) {
+	if in.GetDaoId() != testDaoID {
+		return nil, status.Error(codes.NotFound, "dao not found")
+	}
+
+	return &storagepb.DaoByIDResponse{Dao: testDao()}, nil
+}
+
+func (m *daoClientMock) GetByFilter(_ context.Context, in *storagepb.DaoByFilterRequest, _ ...grpc.CallOption) (*storagepb.DaoByFilterResponse, error) {
+	resp := &storagepb.DaoByFilterResponse{}
+	for _, id := range in.GetDaoIds() {
+		if id == testDaoID {
+			resp.Daos = append(resp.Daos, testDao())
+		}
+	}
+	resp.TotalCount = uint64(len(resp.Daos))
+
+	return resp, nil
+}
+
+func (m *daoClientMock) GetTokenInfo(_ context.Context, _ *storagepb.TokenInfoRequest, _ ...grpc.CallOption) (*storagepb.TokenInfoResponse, error) {
+	return nil, status.Error(codes.Unavailable, "token service is down")
+}
+
+type delegateClientMock struct {
+	storagepb.DelegateClient
+}
+
+func (m *delegateClientMock) GetDelegatesV2(_ context.Context, in *storagepb.GetDelegatesV2Request, _ ...grpc.CallOption) (*storagepb.GetDelegatesV2Response, error) {
+	return &storagepb.GetDelegatesV2Response{
+		List: []*storagepb.DelegatesWrapper{{
+			DaoId:          in.GetDaoId(),
+			DelegationType: storagepb.DelegationType_DELEGATION_TYPE_DELEGATION,
+			TotalCnt:       1,
+			Delegates: []*storagepb.DelegateEntryV2{{
+				Address:     "0x329c54289Ff5D6B7b7daE13592C6B1EDA1543eD4",
+				EnsName:     "aci.eth",
+				VotingPower: pointy.Float64(125000),
+			}},
+		}},
+		TotalCnt: 1,
+	}, nil
+}
+
+func (m *delegateClientMock) GetDelegationSummary(_ context.Context, _ *storagepb.GetDelegationSummaryRequest, _ ...grpc.CallOption) (*storagepb.GetDelegationSummaryResponse, error) {
+	return nil, status.Error(codes.Unavailable, "delegations are not available")
+}
+
+type voteClientMock struct {
+	storagepb.VoteClient
+
+	votes int
+}
+
+func (m *voteClientMock) GetVotes(_ context.Context, in *storagepb.VotesFilterRequest, _ ...grpc.CallOption) (*storagepb.VotesFilterResponse, error) {
+	return &storagepb.VotesFilterResponse{
+		Votes: []*storagepb.VoteInfo{{
+			Id:         "0xvote",
+			DaoId:      testDaoID,
+			ProposalId: testProposalID,
+			Voter:      in.GetVoter(),
+			Created:    1706800000,
+			Choice:     &anypb.Any{Value: []byte(`1`)},
+			Vp:         125000,
+		}},
+		TotalCount: 42,
+	}, nil
+}
+
+func (m *voteClientMock) Validate(_ context.Context, _ *storagepb.ValidateRequest, _ ...grpc.CallOption) (*storagepb.ValidateResponse, error) {
+	return &storagepb.ValidateResponse{Ok: true, VotingPower: 400000}, nil
+}
+
+const testPreparedVoteTypedData = `{"types":{"Vote":[{"name":"from","type":"address"},{"name":"space","type":"string"},{"name":"timestamp","type":"uint64"},{"name":"proposal","type":"bytes32"},{"name":"choice","type":"uint32"},{"name":"reason","type":"string"},{"name":"app","type":"string"},{"name":"metadata","type":"string"}]},"domain":{"name":"snapshot","version":"0.1.4"},"message":{"from":"0xCD2a3d9F938E13CD947Ec05AbC7FE734Df8DD826","space":"aave.eth","timestamp":1706800000,"proposal":"0x6e2ed5f1a0b15b1f5cb4f3b2f1d0f0f38a4b7c61e1b2a3d4c5e6f708192a3b4c","choice":1,"reason":"","app":"goverland","metadata":"{}"}}`
+
+func (m *voteClientMock) Prepare(_ context.Context, _ *storagepb.PrepareRequest, _ ...grpc.CallOption) (*storagepb.PrepareResponse, error) {
+	return &storagepb.PrepareResponse{Id: "0xprepared", TypedData: testPreparedVoteTypedData}, nil
+}
+
+func (m *voteClientMock) Vote(_ context.Context, in *storagepb.VoteRequest, _ ...grpc.CallOption) (*storagepb.VoteResponse, error) {
+	m.votes++
+
+	return &storagepb.VoteResponse{
+		Id:         in.GetId(),
+		Ipfs:       "bafkreivote",
+		Relayer:    &storagepb.Relayer{Address: "0xrelayer", Receipt: "receipt"},
+		ProposalId: testProposalID,
+	}, nil
+}
+
+func (m *voteClientMock) GetDaosVotedIn(_ context.Context, _ *storagepb.DaosVotedInRequest, _ ...grpc.CallOption) (*storagepb.DaosVotedInResponse, error) {
+	return &storagepb.DaosVotedInResponse{DaoIds: []string{testDaoID}, TotalCount: 1}, nil
+}
+
+// pagedVoteClientMock returns the window of the votes by the offset and limit of the request.
+type pagedVoteClientMock struct {
+	storagepb.VoteClient
+
+	votes    []*storagepb.VoteInfo
+	requests int
+	// delay is the time to load every page
+	delay time.Duration
+}
+
+func (m *pagedVoteClientMock) GetVotes(_ context.Context, in *storagepb.VotesFilterRequest, _ ...grpc.CallOption) (*storagepb.VotesFilterResponse, error) {
+	m.requests++
+	time.Sleep(m.delay)
+	start := min(int(in.GetOffset()), len(m.votes))
+	end := min(start+int(in.GetLimit()), len(m.votes))
+
+	return &storagepb.VotesFilterResponse{
+		Votes:      m.votes[start:end],
+		TotalCount: uint64(len(m.votes)),
+		TotalVp:    float32(len(m.votes)),
+	}, nil
+}
+
+type subscriberClientMock struct {
+	feedpb.SubscriberClient
+}
+
+func (m *subscriberClientMock) Create(_ context.Context, _ *feedpb.CreateSubscriberRequest, _ ...grpc.CallOption) (*feedpb.CreateSubscriberResponse, error) {
+	return &feedpb.CreateSubscriberResponse{SubscriberId: "subscriber-id"}, nil
+}
+
+type subscriptionClientMock struct {
+	feedpb.SubscriptionClient
+
+	mu            sync.Mutex
+	subscriberIDs []string
+}
+
+func (m *subscriptionClientMock) Subscribe(ctx context.Context, in *feedpb.SubscribeRequest, _ ...grpc.CallOption) (*feedpb.SubscribeResponse, error) {
+	md, _ := metadata.FromOutgoingContext(ctx)
+
+	m.mu.Lock()
+	m.subscriberIDs = append(m.subscriberIDs, md.Get("subscriber_id")...)
+	m.mu.Unlock()
+
+	if in.GetDaoId() != testDaoID {
+		return nil, status.Error(codes.NotFound, "dao not found")
+	}
+
+	return &feedpb.SubscribeResponse{}, nil
+}
+
+type ensClientMock struct {
+	storagepb.EnsClient
+}
+
+func (m *ensClientMock) GetEnsByAddresses(_ context.Context, in *storagepb.EnsByAddressesRequest, _ ...grpc.CallOption) (*storagepb.EnsByAddressesResponse, error) {
+	resp := &storagepb.EnsByAddressesResponse{}
+	for _, address := range in.GetAddresses() {
+		resp.EnsNames = append(resp.EnsNames, &storagepb.EnsName{Address: address, Name: "aci.eth"})
+	}
+
+	return resp, nil
+}
+
+// GetAddressesByEnsNames knows aci.eth only.
+func (m *ensClientMock) GetAddressesByEnsNames(_ context.Context, in *storagepb.AddressesByEnsNamesRequest, _ ...grpc.CallOption) (*storagepb.AddressesByEnsNamesResponse, error) {
+	resp := &storagepb.AddressesByEnsNamesResponse{}
+	for _, name := range in.GetNames() {
+		if name == "aci.eth" {
+			resp.EnsNames = append(resp.EnsNames, &storagepb.EnsName{Address: "0x329c54289ff5d6b7b7dae13592c6b1eda1543ed4", Name: name})
+		}
+	}
+
+	return resp, nil
+}
+
+type feedClientMock struct {
+	feedpb.FeedClient
+}
+
+func (m *feedClientMock) GetByFilter(_ context.Context, in *feedpb.FeedByFilterRequest, _ ...grpc.CallOption) (*feedpb.FeedByFilterResponse, error) {
+	return &feedpb.FeedByFilterResponse{
+		Items: []*feedpb.FeedInfo{{
+			Id:         "7d1f6c62-3f7b-4f6e-9d0a-2b1c3d4e5f60",
+			CreatedAt:  timestamppb.New(time.Date(2024, 2, 1, 12, 0, 0, 0, time.UTC)),
+			UpdatedAt:  timestamppb.New(time.Date(2024, 2, 1, 12, 0, 0, 0, time.UTC)),
+			DaoId:      in.GetDaoId(),
+			ProposalId: testProposalID,
+			Type:       feedpb.FeedInfo_Proposal,
+			Action:     "proposal.created",
+			Snapshot:   &anypb.Any{Value: []byte(`{"title":"Enable stable rate"}`)},
+		}},
+		TotalCount: 1,
+	}, nil
+}
+
+type proposalClientMock struct {
+	storagepb.ProposalClient
+
+	// level is the info level of the last GetByFilter request
+	level storagepb.ProposalInfoLevel
+}
+
+func (m *proposalClientMock) GetByID(_ context.Context, in *storagepb.ProposalByIDRequest, _ ...grpc.CallOption) (*storagepb.ProposalByIDResponse, error) {
+	if in.GetProposalId() != testProposalID {
+		return nil, status.Error(codes.NotFound, "proposal not found")
+	}
+
+	return &storagepb.ProposalByIDResponse{Proposal: testProposal()}, nil
+}
+
+func (m *proposalClientMock) GetByFilter(_ context.Context, in *storagepb.ProposalByFilterRequest, _ ...grpc.CallOption) (*storagepb.ProposalByFilterResponse, error) {
+	m.level = in.GetLevel()
+	if m.level == storagepb.ProposalInfoLevel_PROPOSAL_INFO_LEVEL_SHORT {
+		p := testProposal()
+
+		return &storagepb.ProposalByFilterResponse{
+			ProposalsShort: []*storagepb.ProposalShortInfo{{Id: p.Id, Title: p.Title, State: p.State, Created: p.Created}},
+			TotalCount:     1,
+		}, nil
+	}
+
+	return &storagepb.ProposalByFilterResponse{
+		Proposals:  []*storagepb.ProposalInfo{testProposal()},
+		TotalCount: 1,
+	}, nil
+}
+
+func testDao() *storagepb.DaoInfo {
+	return &storagepb.DaoInfo{
+		Id:        testDaoID,
+		Alias:     "aave.eth",
+		CreatedAt: timestamppb.New(time.Date(2023, 5, 1, 10, 0, 0, 0, time.UTC)),
+		UpdatedAt: timestamppb.New(time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)),
+		Name:      "Aave",
+		About:     "The Aave Protocol",
+		Avatar:    "ipfs://avatar",
+		Website:   "https://aave.com",
+		Twitter:   "aave",
+		Github:    "aave",
+		Network:   "1",
+		Symbol:    "AAVE",
+		Strategies: []*storagepb.Strategy{
+			{Name: "erc20-balance-of", Network: "1", Params: []byte(`{"symbol":"AAVE","decimals":18}`)},
+		},
+		Voting: &storagepb.Voting{
+			Period: 259200,
+			Type:   "single-choice",
+			Quorum: 320000,
+		},
+		Categories: []string{"protocol"},
+		Treasuries: []*storagepb.Treasury{
+			{Name: "Ecosystem Reserve", Address: "0x25f2226b597e8f9514b3f68f00f494cf4f286491", Network: "1"},
+		},
+		FollowersCount:     1200,
+		ProposalsCount:     420,
+		ActivitySince:      1620000000,
+		VotersCount:        9000,
+		ActiveVotes:        2,
+		ActiveProposalsIds: []string{testProposalID},
+		Verified:           true,
+		PopularityIndex:    123.5,
+		TokenExist:         true,
+		TokenSymbol:        "AAVE",
+		FungibleId:         "aave",
+	}
+}
+
+func testProposal() *storagepb.ProposalInfo {
+	return &storagepb.ProposalInfo{
+		Id:          testProposalID,
+		CreatedAt:   timestamppb.New(time.Date(2024, 2, 1, 12, 0, 0, 0, time.UTC)),
+		UpdatedAt:   timestamppb.New(time.Date(2024, 2, 2, 12, 0, 0, 0, time.UTC)),
+		Ipfs:        "bafkreiexample",
+		Author:      "0x329c54289Ff5D6B7b7daE13592C6B1EDA1543eD4",
+		EnsName:     "aci.eth",
+		Created:     1706788800,
+		DaoId:       testDaoID,
+		Network:     "1",
+		Symbol:      "AAVE",
+		Type:        "single-choice",
+		Title:       "Enable stable rate",
+		Body:        "## Summary",
+		Choices:     []string{"For", "Against", "Abstain"},
+		Start:       1706788800,
+		End:         1707048000,
+		Quorum:      320000,
+		State:       "active",
+		Link:        "https://snapshot.org/#/aave.eth/proposal/" + testProposalID,
+		App:         "snapshot",
+		Scores:      []float32{350000, 1000, 0},
+		ScoresState: "pending",
+		ScoresTotal: 351000,
+		Votes:       120,
+		Timeline: []*storagepb.ProposalTimelineItem{
+			{
+				CreatedAt: timestamppb.New(time.Date(2024, 2, 1, 12, 0, 0, 0, time.UTC)),
+				Action:    storagepb.ProposalTimelineItem_ProposalCreated,
+			},
+		},
+		InitialTokenPrice: 95.5,
+	}
+}
+
+func newTestServer(t *testing.T) http.Handler {
+	t.Helper()
+
+	dc := &daoClientMock{}
+	pc := &proposalClientMock{}
+
+	gateway, err := NewGateway(context.Background(), ingrpc.NewDaoServer(dc), ingrpc.NewProposalServer(pc, nil))
+	if err != nil {
+		t.Fatalf("create gateway: %v", err)
+	}
+
+	handlers := []apihandlers.APIHandler{
+		apihandlers.NewDaoHandler(dc, pc, nil, nil, nil, nil, ""),
+		apihandlers.NewProposalHandler(pc, nil, dc, nil, nil, time.Minute),
+	}
+
+	return NewRestServer(config.REST{HandleTimeout: time.Second, BatchMaxRequests: 3, BatchTimeout: time.Second}, handlers, gateway).Handler
+}
+
+func serve(t *testing.T, srv http.Handler, method, path string) *httptest.ResponseRecorder {
+	t.Helper()
+
+	rec := httptest.NewRecorder()
+	srv.ServeHTTP(rec, httptest.NewRequest(method, path, nil))
+
+	return rec
+}
+
+func assertJSONEqualsFile(t *testing.T, body []byte, file string) {
+	t.Helper()
+
+	path := filepath.Join("testdata", "contract", file)
+	if *update {
+		var buf bytes.Buffer
+		if err := json.Indent(&buf, bytes.TrimSpace(body), "", "  "); err != nil {
+			t.Fatalf("indent response: %v", err)
+		}
+		buf.WriteByte('\n')
+		if err := os.WriteFile(path, buf.Bytes(), 0o644); err != nil {
+			t.Fatalf("write golden file: %v", err)
+		}
+	}
+
+	expected, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("read golden file: %v", err)
+	}
+
+	var got, want any
+	if err := json.Unmarshal(body, &got); err != nil {
+		t.Fatalf("unmarshal response: %v; body: %s", err, body)
+	}
+	if err := json.Unmarshal(expected, &want); err != nil {
+		t.Fatalf("unmarshal golden file: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("response does not match %s\ngot:  %s\nwant: %s", file, body, expected)
+	}
+}
+
+// assertBodyEqualsFile compares the non-JSON response with the golden file byte by byte.
+func assertBodyEqualsFile(t *testing.T, body []byte, file string) {
+	t.Helper()
+
+	path := filepath.Join("testdata", "contract", file)
+	if *update {
+		if err := os.WriteFile(path, body, 0o644); err != nil {
+			t.Fatalf("write golden file: %v", err)
+		}
+	}
+
+	expected, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("read golden file: %v", err)
+	}
+
+	if !bytes.Equal(body, expected) {
+		t.Errorf("response does not match %s\ngot:  %s\nwant: %s", file, body, expected)
+	}
+}
+
+func TestContract_V1(t *testing.T) {
+	srv := newTestServer(t)
+
+	tests := []struct {
+		name       string
+		path       string
+		wantStatus int
+		golden     string
+	}{
+		{"dao by id", "/v1/daos/" + testDaoID, http.StatusOK, "v1_dao_by_id.json"},
+		{"dao not found", "/v1/daos/unknown", http.StatusNotFound, "v1_not_found.json"},
+		{"proposal by id", "/v1/proposals/" + testProposalID, http.StatusOK, "v1_proposal_by_id.json"},
+		{"proposal list", "/v1/proposals?dao=aave.eth", http.StatusOK, "v1_proposal_list.json"},
+		{"proposal results", "/v1/proposals/" + testProposalID + "/results", http.StatusOK, "v1_proposal_results.json"},
+		{"proposal results not found", "/v1/proposals/unknown/results", http.StatusNotFound, "v1_not_found.json"},
+		{"dao by id with fields", "/v1/daos/" + testDaoID + "?fields=id,name,alias", http.StatusOK, "v1_dao_by_id_fields.json"},
+		{"proposal by id with fields", "/v1/proposals/" + testProposalID + "?fields=id,title,dao_id", http.StatusOK, "v1_proposal_by_id_fields.json"},
+		{"proposal list with fields", "/v1/proposals?fields=id,title,state", http.StatusOK, "v1_proposal_list_fields.json"},
+		{"proposal list with dao", "/v1/proposals?fields=id,title&include=dao", http.StatusOK, "v1_proposal_list_include_dao.json"},
+		{"unknown field", "/v1/proposals?fields=id,unknown", http.StatusBadRequest, "v1_unknown_field.json"},
+		{"unknown include", "/v1/proposals?include=author", http.StatusBadRequest, "v1_unknown_include.json"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := serve(t, srv, http.MethodGet, tt.path)
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+
+			assertJSONEqualsFile(t, rec.Body.Bytes(), tt.golden)
+		})
+	}
+}
+
+func TestContract_V1ProposalLevel(t *testing.T) {
+	pc := &proposalClientMock{}
+	handlers := []apihandlers.APIHandler{apihandlers.NewProposalHandler(pc, nil, &daoClientMock{}, nil, nil, time.Minute)}
+	srv := NewRestServer(config.REST{HandleTimeout: time.Second}, handlers, nil).Handler
+
+	for path, want := range map[string]storagepb.ProposalInfoLevel{
+		"/v1/proposals":                                              storagepb.ProposalInfoLevel_PROPOSAL_INFO_LEVEL_UNSPECIFIED,
+		"/v1/proposals?fields=id,title":                              storagepb.ProposalInfoLevel_PROPOSAL_INFO_LEVEL_SHORT,
+		"/v1/proposals/top?fields=id,created":                        storagepb.ProposalInfoLevel_PROPOSAL_INFO_LEVEL_SHORT,
+		"/v1/proposals?fields=id,body":                               storagepb.ProposalInfoLevel_PROPOSAL_INFO_LEVEL_UNSPECIFIED,
+		"/v1/proposals?fields=id,title&include=dao":                  storagepb.ProposalInfoLevel_PROPOSAL_INFO_LEVEL_UNSPECIFIED,
+		"/v1/proposals?fields=id,state&state=closed":                 storagepb.ProposalInfoLevel_PROPOSAL_INFO_LEVEL_SHORT,
+		"/v1/proposals?dao=aave.eth&fields=id,state&sort=most_votes": storagepb.ProposalInfoLevel_PROPOSAL_INFO_LEVEL_UNSPECIFIED,
+	} {
+		pc.level = storagepb.ProposalInfoLevel_PROPOSAL_INFO_LEVEL_FULL
+		if rec := serve(t, srv, http.MethodGet, path); rec.Code != http.StatusOK {
+			t.Fatalf("%s: status = %d", path, rec.Code)
+		}
+		if pc.level != want {
+			t.Errorf("%s: level = %s, want %s", path, pc.level, want)
+		}
+	}
+}
+
+func TestContract_V1PaginationHeaders(t *testing.T) {
+	rec := serve(t, newTestServer(t), http.MethodGet, "/v1/proposals?offset=5&limit=10")
+
+	headers := map[string]string{
+		"X-Total-Count": "1",
+		"X-Offset":      "5",
+		"X-Limit":       "10",
+		"Link":          `</v1/proposals?limit=10&offset=0>; rel="first", </v1/proposals?limit=10&offset=0>; rel="prev", </v1/proposals?limit=10&offset=0>; rel="last"`,
+	}
+	for name, want := range headers {
+		if got := rec.Header().Get(name); got != want {
+			t.Errorf("header %s = %q, want %q", name, got, want)
+		}
+	}
+}
+
+func TestContract_V1ProposalListFilters(t *testing.T) {
+	pc := &proposalClientMock{}
+	handlers := []apihandlers.APIHandler{apihandlers.NewProposalHandler(pc, nil, &daoClientMock{}, nil, nil, time.Minute)}
+	srv := NewRestServer(config.REST{HandleTimeout: time.Second}, handlers, nil).Handler
+
+	tests := []struct {
+		name       string
+		path       string
+		wantStatus int
+		wantTotal  string
+		golden     string
+	}{
+		{"matched", "/v1/proposals?dao=aave.eth&state=pending,active&type=single-choice&min_votes=100&sort=most_votes", http.StatusOK, "1", "v1_proposal_list.json"},
+		{"matched short fields", "/v1/proposals?proposals=" + testProposalID + "&fields=id,title,state&author=0x329c54289Ff5D6B7b7daE13592C6B1EDA1543eD4&exclude_spam=true", http.StatusOK, "1", "v1_proposal_list_fields.json"},
+		{"filtered out", "/v1/proposals?dao=aave.eth&state=closed&end_from=1706788800", http.StatusOK, "0", "v1_proposal_list_filtered_empty.json"},
+		{"unscoped", "/v1/proposals?state=closed&end_from=1706788800", http.StatusBadRequest, "", "v1_proposal_list_unscoped.json"},
+		{"reversed range", "/v1/proposals?created_from=1706788800&created_to=1706700000", http.StatusBadRequest, "", "v1_proposal_list_reversed_range.json"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := serve(t, srv, http.MethodGet, tt.path)
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d: %s", rec.Code, tt.wantStatus, rec.Body)
+			}
+			if got := rec.Header().Get("X-Total-Count"); got != tt.wantTotal {
+				t.Errorf("X-Total-Count = %q, want %q", got, tt.wantTotal)
+			}
+
+			assertJSONEqualsFile(t, rec.Body.Bytes(), tt.golden)
+		})
+	}
+
+	// the proposals are filtered by the fields missed in the short info
+	if pc.level != storagepb.ProposalInfoLevel_PROPOSAL_INFO_LEVEL_UNSPECIFIED {
+		t.Errorf("level = %v, want the full info", pc.level)
+	}
+}
+
+func TestContract_V1Batch(t *testing.T) {
+	srv := newTestServer(t)
+
+	tests := []struct {
+		name       string
+		body       string
+		wantStatus int
+		golden     string
+	}{
+		{
+			"sub-requests",
+			`{"requests":[{"id":"dao","path":"/v1/daos/` + testDaoID + `?fields=id,name"},{"id":"missed","path":"/v1/daos/unknown"},{"id":"proposals","method":"get","path":"/v1/proposals?fields=id,title&limit=1"}]}`,
+			http.StatusOK,
+			"v1_batch.json",
+		},
+		{
+			"invalid sub-requests",
+			`{"requests":[{"method":"PUT","path":"/v1/daos"},{"path":"/v1/batch"},{"path":"/openapi.json"}]}`,
+			http.StatusBadRequest,
+			"v1_batch_invalid.json",
+		},
+		{
+			"streamed sub-requests",
+			`{"requests":[{"path":"/v1/proposals/` + testProposalID + `/votes/export"},{"path":"/v1/daos/` + testDaoID + `/feed.atom"},{"path":"/v1/daos/` + testDaoID + `/proposals.ics"}]}`,
+			http.StatusBadRequest,
+			"v1_batch_streamed.json",
+		},
+		{
+			"too many sub-requests",
+			`{"requests":[{"path":"/v1/daos"},{"path":"/v1/daos"},{"path":"/v1/daos"},{"path":"/v1/daos"}]}`,
+			http.StatusBadRequest,
+			"v1_batch_too_many.json",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			srv.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, BatchPath, strings.NewReader(tt.body)))
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d: %s", rec.Code, tt.wantStatus, rec.Body)
+			}
+
+			assertJSONEqualsFile(t, rec.Body.Bytes(), tt.golden)
+		})
+	}
+}
+
+func TestContract_V1DaoOverview(t *testing.T) {
+	dc := &daoClientMock{}
+	handlers := []apihandlers.APIHandler{
+		apihandlers.NewDaoHandler(dc, &proposalClientMock{}, &feedClientMock{}, &delegateClientMock{}, nil, nil, ""),
+	}
+	srv := NewRestServer(config.REST{HandleTimeout: time.Second}, handlers, nil).Handler
+
+	rec := serve(t, srv, http.MethodGet, "/v1/daos/"+testDaoID+"/overview?proposals=1&delegates=1&feed=1")
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusOK)
+	}
+	assertJSONEqualsFile(t, rec.Body.Bytes(), "v1_dao_overview.json")
+
+	rec = serve(t, srv, http.MethodGet, "/v1/daos/unknown/overview")
+	if rec.Code != http.StatusNotFound {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusNotFound)
+	}
+	assertJSONEqualsFile(t, rec.Body.Bytes(), "v1_not_found.json")
+}
+
+func TestContract_V1UserProfile(t *testing.T) {
+	ec := &ensClientMock{}
+	handlers := []apihandlers.APIHandler{
+		apihandlers.NewUserHandler(&voteClientMock{}, &daoClientMock{}, &proposalClientMock{}, &delegateClientMock{}, ec, ihelpers.NewIdentifierResolver(ec)),
+	}
+	srv := NewRestServer(config.REST{HandleTimeout: time.Second}, handlers, nil).Handler
+
+	rec := serve(t, srv, http.MethodGet, "/v1/user/0x329c54289Ff5D6B7b7daE13592C6B1EDA1543eD4/profile?votes=1")
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusOK)
+	}
+	assertJSONEqualsFile(t, rec.Body.Bytes(), "v1_user_profile.json")
+}
+
+func TestContract_V1UserVotesEnsNames(t *testing.T) {
+	ec := &ensClientMock{}
+	handlers := []apihandlers.APIHandler{
+		apihandlers.NewVotesHandler(&voteClientMock{}, &daoClientMock{}, &proposalClientMock{}, ihelpers.NewIdentifierResolver(ec), ihelpers.NewEnsEnricher(ec)),
+	}
+	srv := NewRestServer(config.REST{HandleTimeout: time.Second}, handlers, nil).Handler
+
+	rec := serve(t, srv, http.MethodGet, "/v1/user/0x329c54289Ff5D6B7b7daE13592C6B1EDA1543eD4/votes")
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusOK)
+	}
+	assertJSONEqualsFile(t, rec.Body.Bytes(), "v1_user_votes.json")
+}
+
+func TestContract_V1EnsIdentifiers(t *testing.T) {
+	ec := &ensClientMock{}
+	resolver := ihelpers.NewIdentifierResolver(ec)
+	handlers := []apihandlers.APIHandler{
+		apihandlers.NewVotesHandler(&voteClientMock{}, &daoClientMock{}, &proposalClientMock{}, resolver, nil),
+		apihandlers.NewProposalHandler(&proposalClientMock{}, &voteClientMock{}, &daoClientMock{}, resolver, nil, time.Minute),
+	}
+	srv := NewRestServer(config.REST{HandleTimeout: time.Second}, handlers, nil).Handler
+
+	tests := []struct {
+		name        string
+		method      string
+		path        string
+		body        string
+		wantStatus  int
+		wantAddress string
+	}{
+		{"path param", http.MethodGet, "/v1/user/aci.eth/votes", "", http.StatusOK, "0x329c54289Ff5D6B7b7daE13592C6B1EDA1543eD4"},
+		{"path param given as address", http.MethodGet, "/v1/user/0x329c54289ff5d6b7b7dae13592c6b1eda1543ed4/votes", "", http.StatusOK, ""},
+		{"unknown path param", http.MethodGet, "/v1/user/unknown.eth/votes", "", http.StatusBadRequest, ""},
+		{"body field", http.MethodPost, "/v1/proposals/" + testProposalID + "/votes/validate", `{"voter":"aci.eth"}`, http.StatusOK, "0x329c54289Ff5D6B7b7daE13592C6B1EDA1543eD4"},
+		{"unknown body field", http.MethodPost, "/v1/proposals/" + testProposalID + "/votes/validate", `{"voter":"unknown.eth"}`, http.StatusBadRequest, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			srv.ServeHTTP(rec, httptest.NewRequest(tt.method, tt.path, strings.NewReader(tt.body)))
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d: %s", rec.Code, tt.wantStatus, rec.Body)
+			}
+
+			if got := rec.Header().Get(response.HeaderResolvedAddress); got != tt.wantAddress {
+				t.Errorf("%s = %q, want %q", response.HeaderResolvedAddress, got, tt.wantAddress)
+			}
+			wantName := ""
+			if tt.wantAddress != "" {
+				wantName = "aci.eth"
+			}
+			if got := rec.Header().Get(response.HeaderResolvedEnsName); got != wantName {
+				t.Errorf("%s = %q, want %q", response.HeaderResolvedEnsName, got, wantName)
+			}
+		})
+	}
+}
+
+func TestContract_V1VotesExport(t *testing.T) {
+	votes := make([]*storagepb.VoteInfo, 1500)
+	for i := range votes {
+		votes[i] = &storagepb.VoteInfo{
+			Id:         fmt.Sprintf("0xvote%d", i),
+			DaoId:      testDaoID,
+			ProposalId: testProposalID,
+			Voter:      "0x329c54289ff5d6b7b7dae13592c6b1eda1543ed4",
+			Created:    1706800000,
+			Reason:     "Looks good, ship it",
+			Choice:     &anypb.Any{Value: []byte(`2`)},
+			Vp:         1.5,
+			VpState:    "final",
+			App:        "goverland",
+		}
+	}
+
+	ec := &ensClientMock{}
+	newServer := func(vc storagepb.VoteClient) http.Handler {
+		resolver, ens := ihelpers.NewIdentifierResolver(ec), ihelpers.NewEnsEnricher(ec)
+		handlers := []apihandlers.APIHandler{
+			apihandlers.NewProposalHandler(&proposalClientMock{}, vc, &daoClientMock{}, resolver, ens, time.Minute),
+			apihandlers.NewVotesHandler(vc, &daoClientMock{}, &proposalClientMock{}, resolver, ens),
+		}
+
+		return NewRestServer(config.REST{HandleTimeout: time.Second}, handlers, nil).Handler
+	}
+	export := func(t *testing.T, srv http.Handler, path, accept string) *httptest.ResponseRecorder {
+		t.Helper()
+
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		req.Header.Set("Accept", accept)
+		rec := httptest.NewRecorder()
+		srv.ServeHTTP(rec, req)
+
+		return rec
+	}
+
+	t.Run("ndjson pages through all votes", func(t *testing.T) {
+		vc := &pagedVoteClientMock{votes: votes}
+		rec := export(t, newServer(vc), "/v1/proposals/"+testProposalID+"/votes/export", "application/x-ndjson")
+		if rec.Code != http.StatusOK {
+			t.Fatalf("status = %d, want %d: %s", rec.Code, http.StatusOK, rec.Body)
+		}
+		if got := rec.Header().Get("Content-Type"); got != "application/x-ndjson" {
+			t.Errorf("Content-Type = %q", got)
+		}
+		if got := rec.Header().Get(response.HeaderTotalCount); got != "1500" {
+			t.Errorf("%s = %q, want 1500", response.HeaderTotalCount, got)
+		}
+		if vc.requests != 2 {
+			t.Errorf("requests = %d, want 2", vc.requests)
+		}
+
+		lines := strings.Split(strings.TrimSpace(rec.Body.String()), "\n")
+		if len(lines) != len(votes) {
+			t.Fatalf("lines = %d, want %d", len(lines), len(votes))
+		}
+		want := `{"id":"0xvote0","proposal_id":"` + testProposalID + `","dao_id":"` + testDaoID + `",` +
+			`"voter":"0x329c54289Ff5D6B7b7daE13592C6B1EDA1543eD4","ens_name":"aci.eth","created":1706800000,` +
+			`"choice":2,"choice_label":"Against","vp":1.5,"vp_state":"final","reason":"Looks good, ship it","app":"goverland"}`
+		if lines[0] != want {
+			t.Errorf("first line = %s, want %s", lines[0], want)
+		}
+	})
+
+	t.Run("pages outlive the write timeout", func(t *testing.T) {
+		// every page is loaded slower than the half of the write timeout
+		many := make([]*storagepb.VoteInfo, 0, 3*len(votes))
+		for range 3 {
+			many = append(many, votes...)
+		}
+		vc := &pagedVoteClientMock{votes: many, delay: 150 * time.Millisecond}
+		ts := httptest.NewUnstartedServer(newServer(vc))
+		ts.Config.WriteTimeout = 200 * time.Millisecond
+		ts.Start()
+		defer ts.Close()
+
+		resp, err := http.Get(ts.URL + "/v1/proposals/" + testProposalID + "/votes/export")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatalf("read export: %v", err)
+		}
+		if vc.requests != 5 {
+			t.Errorf("requests = %d, want 5", vc.requests)
+		}
+		if lines := strings.Count(string(body), "\n"); lines != 3*len(votes) {
+			t.Errorf("lines = %d, want %d", lines, 3*len(votes))
+		}
+	})
+
+	t.Run("csv of user votes", func(t *testing.T) {
+		rec := export(t, newServer(&pagedVoteClientMock{votes: votes[:2]}), "/v1/user/aci.eth/votes/export", "text/csv")
+		if rec.Code != http.StatusOK {
+			t.Fatalf("status = %d, want %d: %s", rec.Code, http.StatusOK, rec.Body)
+		}
+		if got := rec.Header().Get("Content-Disposition"); got != `attachment; filename="votes-0x329c54289Ff5D6B7b7daE13592C6B1EDA1543eD4.csv"` {
+			t.Errorf("Content-Disposition = %q", got)
+		}
+		if got := rec.Header().Get(response.HeaderResolvedAddress); got != "0x329c54289Ff5D6B7b7daE13592C6B1EDA1543eD4" {
+			t.Errorf("%s = %q", response.HeaderResolvedAddress, got)
+		}
+
+		row := "," + testProposalID + "," + testDaoID + ",0x329c54289Ff5D6B7b7daE13592C6B1EDA1543eD4,aci.eth,1706800000,2,Against,1.5,final,\"Looks good, ship it\",goverland\n"
+		want := "id,proposal_id,dao_id,voter,ens_name,created,choice,choice_label,vp,vp_state,reason,app\n" +
+			"0xvote0" + row + "0xvote1" + row
+		if got := rec.Body.String(); got != want {
+			t.Errorf("body = %s, want %s", got, want)
+		}
+	})
+
+	t.Run("unknown proposal", func(t *testing.T) {
+		rec := export(t, newServer(&pagedVoteClientMock{}), "/v1/proposals/0xunknown/votes/export", "")
+		if rec.Code != http.StatusNotFound {
+			t.Fatalf("status = %d, want %d", rec.Code, http.StatusNotFound)
+		}
+	})
+
+	t.Run("unsupported format", func(t *testing.T) {
+		rec := export(t, newServer(&pagedVoteClientMock{}), "/v1/proposals/"+testProposalID+"/votes/export", "application/json")
+		if rec.Code != http.StatusUnprocessableEntity {
+			t.Fatalf("status = %d, want %d", rec.Code, http.StatusUnprocessableEntity)
+		}
+	})
+}
+
+func TestContract_V1SyndicationFeeds(t *testing.T) {
+	handlers := []apihandlers.APIHandler{
+		apihandlers.NewDaoHandler(&daoClientMock{}, nil, &feedClientMock{}, nil, nil, nil, testPublicBaseURL),
+		apihandlers.NewFeedHandler(&feedClientMock{}, testPublicBaseURL),
+	}
+	srv := NewRestServer(config.REST{HandleTimeout: time.Second}, handlers, nil).Handler
+
+	tests := []struct {
+		path        string
+		contentType string
+		golden      string
+	}{
+		{"/v1/daos/" + testDaoID + "/feed.atom", "application/atom+xml; charset=utf-8", "v1_dao_feed.atom"},
+		{"/v1/daos/" + testDaoID + "/feed.rss", "application/rss+xml; charset=utf-8", "v1_dao_feed.rss"},
+		{"/v1/feed.atom?dao_list=" + testDaoID, "application/atom+xml; charset=utf-8", "v1_feed.atom"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			rec := serve(t, srv, http.MethodGet, tt.path)
+			if rec.Code != http.StatusOK {
+				t.Fatalf("status = %d, want %d: %s", rec.Code, http.StatusOK, rec.Body)
+			}
+			if got := rec.Header().Get("Content-Type"); got != tt.contentType {
+				t.Errorf("Content-Type = %q, want %q", got, tt.contentType)
+			}
+			assertBodyEqualsFile(t, rec.Body.Bytes(), tt.golden)
+		})
+	}
+
+	rec := serve(t, srv, http.MethodGet, "/v1/daos/2bc3e1d4-0000-4d4a-9d39-9b37a4dcb0a1/feed.atom")
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("unknown dao status = %d, want %d", rec.Code, http.StatusNotFound)
+	}
+}
+
+func TestContract_V1Calendars(t *testing.T) {
+	ec := &ensClientMock{}
+	handlers := []apihandlers.APIHandler{
+		apihandlers.NewDaoHandler(&daoClientMock{}, &proposalClientMock{}, nil, nil, nil, nil, ""),
+		apihandlers.NewUserHandler(&voteClientMock{}, &daoClientMock{}, &proposalClientMock{}, nil, ec, ihelpers.NewIdentifierResolver(ec)),
+	}
+	srv := NewRestServer(config.REST{HandleTimeout: time.Second}, handlers, nil).Handler
+
+	tests := []struct {
+		path   string
+		golden string
+	}{
+		{"/v1/daos/" + testDaoID + "/proposals.ics", "v1_dao_proposals.ics"},
+		{"/v1/user/aci.eth/calendar.ics", "v1_user_calendar.ics"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			rec := serve(t, srv, http.MethodGet, tt.path)
+			if rec.Code != http.StatusOK {
+				t.Fatalf("status = %d, want %d: %s", rec.Code, http.StatusOK, rec.Body)
+			}
+			if got := rec.Header().Get("Content-Type"); got != "text/calendar; charset=utf-8" {
+				t.Errorf("Content-Type = %q", got)
+			}
+			assertBodyEqualsFile(t, rec.Body.Bytes(), tt.golden)
+		})
+	}
+}
+
+func TestContract_V1VoteSimulation(t *testing.T) {
+	handlers := []apihandlers.APIHandler{apihandlers.NewProposalHandler(&proposalClientMock{}, &voteClientMock{}, &daoClientMock{}, nil, nil, time.Minute)}
+	srv := NewRestServer(config.REST{HandleTimeout: time.Second}, handlers, nil).Handler
+
+	tests := []struct {
+		name       string
+		body       string
+		wantStatus int
+		golden     string
+	}{
+		{"vote changes the winner", `{"voter":"0x329c54289Ff5D6B7b7daE13592C6B1EDA1543eD4","choice":2}`, http.StatusOK, "v1_vote_simulation.json"},
+		{"invalid choice", `{"voter":"0x329c54289Ff5D6B7b7daE13592C6B1EDA1543eD4","choice":[1,2]}`, http.StatusBadRequest, "v1_vote_simulation_invalid_choice.json"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			path := "/v1/proposals/" + testProposalID + "/votes/simulate"
+			srv.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, path, strings.NewReader(tt.body)))
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d: %s", rec.Code, tt.wantStatus, rec.Body)
+			}
+
+			assertJSONEqualsFile(t, rec.Body.Bytes(), tt.golden)
+		})
+	}
+}
+
+func TestContract_V1SubscribeWebhookURL(t *testing.T) {
+	webhookURLs := ihelpers.NewWebhookURLValidator(ihelpers.WebhookURLPolicy{HTTPSOnly: true}, net.DefaultResolver)
+	handlers := []apihandlers.APIHandler{apihandlers.NewSubscribeHandler(nil, nil, webhookURLs, nil)}
+	srv := NewRestServer(config.REST{HandleTimeout: time.Second}, handlers, nil).Handler
+
+	rec := httptest.NewRecorder()
+	body := `{"webhook_url":"https://169.254.169.254/latest/meta-data"}`
+	srv.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/v1/subscribe", strings.NewReader(body)))
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("status = %d, want %d: %s", rec.Code, http.StatusBadRequest, rec.Body)
+	}
+
+	assertJSONEqualsFile(t, rec.Body.Bytes(), "v1_subscribe_invalid_webhook_url.json")
+}
+
+func TestContract_V1SubscriberToken(t *testing.T) {
+	subscriptions := &subscriptionClientMock{}
+	tokens := auth.NewTokens([]byte("0123456789abcdef0123456789abcdef"), time.Hour, 24*time.Hour, false)
+	webhookURLs := ihelpers.NewWebhookURLValidator(ihelpers.WebhookURLPolicy{}, net.DefaultResolver)
+	handlers := []apihandlers.APIHandler{apihandlers.NewSubscribeHandler(&subscriberClientMock{}, subscriptions, webhookURLs, tokens)}
+	srv := NewRestServer(config.REST{HandleTimeout: time.Second}, handlers, nil).Handler
+
+	do := func(method, path, token, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, strings.NewReader(body))
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		rec := httptest.NewRecorder()
+		srv.ServeHTTP(rec, req)
+
+		return rec
+	}
+	subscribe := `{"dao":"` + testDaoID + `"}`
+
+	rec := do(http.MethodPost, "/v1/subscribe", "", `{"webhook_url":"https://93.184.216.34/hook"}`)
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("create status = %d: %s", rec.Code, rec.Body)
+	}
+	var created struct {
+		SubscriberID string `json:"subscriber_id"`
+		Token        string `json:"token"`
+		RefreshToken string `json:"refresh_token"`
+	}
+	_ = json.Unmarshal(rec.Body.Bytes(), &created)
+
+	if rec := do(http.MethodPost, "/v1/subscriptions", created.Token, subscribe); rec.Code != http.StatusOK {
+		t.Fatalf("subscribe status = %d: %s", rec.Code, rec.Body)
+	}
+	if !reflect.DeepEqual(subscriptions.subscriberIDs, []string{"subscriber-id"}) {
+		t.Errorf("subscriber ids sent upstream = %v", subscriptions.subscriberIDs)
+	}
+
+	for name, token := range map[string]string{"missing": "", "raw subscriber id": created.SubscriberID} {
+		if rec := do(http.MethodPost, "/v1/subscriptions", token, subscribe); rec.Code != http.StatusUnauthorized {
+			t.Errorf("%s token: status = %d, want %d", name, rec.Code, http.StatusUnauthorized)
+		}
+	}
+
+	if rec := do(http.MethodPost, "/v1/subscribe/token", created.Token, ""); rec.Code != http.StatusUnauthorized {
+		t.Errorf("refresh by access token: status = %d, want %d", rec.Code, http.StatusUnauthorized)
+	}
+	if rec := do(http.MethodPost, "/v1/subscriptions", created.RefreshToken, subscribe); rec.Code != http.StatusUnauthorized {
+		t.Errorf("refresh token: status = %d, want %d", rec.Code, http.StatusUnauthorized)
+	}
+
+	rec = do(http.MethodPost, "/v1/subscribe/token", created.RefreshToken, "")
+	if rec.Code != http.StatusOK {
+		t.Fatalf("refresh status = %d: %s", rec.Code, rec.Body)
+	}
+	var refreshed struct {
+		Token        string `json:"token"`
+		RefreshToken string `json:"refresh_token"`
+	}
+	_ = json.Unmarshal(rec.Body.Bytes(), &refreshed)
+
+	if rec := do(http.MethodPost, "/v1/subscribe/token", created.RefreshToken, ""); rec.Code != http.StatusUnauthorized {
+		t.Errorf("used refresh token: status = %d, want %d", rec.Code, http.StatusUnauthorized)
+	}
+	if rec := do(http.MethodDelete, "/v1/subscribe/token", refreshed.Token, ""); rec.Code != http.StatusOK {
+		t.Fatalf("revoke status = %d: %s", rec.Code, rec.Body)
+	}
+	if rec := do(http.MethodPost, "/v1/subscriptions", refreshed.Token, subscribe); rec.Code != http.StatusUnauthorized {
+		t.Errorf("revoked token: status = %d, want %d", rec.Code, http.StatusUnauthorized)
+	}
+	if rec := do(http.MethodPost, "/v1/subscribe/token", refreshed.RefreshToken, ""); rec.Code != http.StatusUnauthorized {
+		t.Errorf("refresh token of the revoked token: status = %d, want %d", rec.Code, http.StatusUnauthorized)
+	}
+}
+
+func TestContract_V1SubscriberRawID(t *testing.T) {
+	tokens := auth.NewTokens([]byte("0123456789abcdef0123456789abcdef"), time.Hour, 24*time.Hour, true)
+	handlers := []apihandlers.APIHandler{apihandlers.NewSubscribeHandler(&subscriberClientMock{}, &subscriptionClientMock{}, nil, tokens)}
+	srv := NewRestServer(config.REST{HandleTimeout: time.Second}, handlers, nil).Handler
+
+	do := func(method, path, token string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, nil)
+		req.Header.Set("Authorization", token)
+		rec := httptest.NewRecorder()
+		srv.ServeHTTP(rec, req)
+
+		return rec
+	}
+
+	rec := do(http.MethodDelete, "/v1/subscribe/token", "subscriber-id")
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("revoke raw id: status = %d, want %d", rec.Code, http.StatusBadRequest)
+	}
+	assertJSONEqualsFile(t, rec.Body.Bytes(), "v1_subscriber_token_revoke_raw_id.json")
+
+	rec = do(http.MethodPost, "/v1/subscribe/token", "subscriber-id")
+	if rec.Code != http.StatusOK {
+		t.Fatalf("exchange status = %d: %s", rec.Code, rec.Body)
+	}
+	var exchanged struct {
+		Token string `json:"token"`
+	}
+	_ = json.Unmarshal(rec.Body.Bytes(), &exchanged)
+
+	claims, err := tokens.Verify(exchanged.Token)
+	if err != nil {
+		t.Fatalf("exchanged token: %v", err)
+	}
+	if claims.Subject != "subscriber-id" {
+		t.Errorf("subject = %q, want subscriber-id", claims.Subject)
+	}
+}
+
+func TestContract_V1IdempotentSubscribe(t *testing.T) {
+	tokens := auth.NewTokens([]byte("0123456789abcdef0123456789abcdef"), time.Hour, 24*time.Hour, false)
+	webhookURLs := ihelpers.NewWebhookURLValidator(ihelpers.WebhookURLPolicy{}, net.DefaultResolver)
+	handlers := []apihandlers.APIHandler{apihandlers.NewSubscribeHandler(&subscriberClientMock{}, nil, webhookURLs, tokens)}
+	srv := NewRestServer(config.REST{HandleTimeout: time.Second, IdempotencyTTL: time.Minute, IdempotencyMaxKeys: 10}, handlers, nil).Handler
+
+	do := func(webhookURL string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/v1/subscribe", strings.NewReader(`{"webhook_url":"`+webhookURL+`"}`))
+		req.Header.Set(IdempotencyKeyHeader, "key")
+		rec := httptest.NewRecorder()
+		srv.ServeHTTP(rec, req)
+
+		return rec
+	}
+
+	if rec := do("https://93.184.216.34/hook"); rec.Code != http.StatusCreated || !strings.Contains(rec.Body.String(), `"token"`) {
+		t.Fatalf("create: status = %d: %s", rec.Code, rec.Body)
+	}
+
+	rec := do("https://93.184.216.34/hook")
+	if rec.Code != http.StatusCreated || rec.Header().Get(IdempotentReplayedHeader) != "true" {
+		t.Fatalf("retry: status = %d, replayed = %q", rec.Code, rec.Header().Get(IdempotentReplayedHeader))
+	}
+	if got := strings.TrimSpace(rec.Body.String()); got != `{"subscriber_id":"subscriber-id"}` {
+		t.Errorf("retry body = %s, want the subscriber ID only", got)
+	}
+
+	if rec := do("https://93.184.216.35/hook"); rec.Header().Get(IdempotentReplayedHeader) != "" {
+		t.Errorf("another webhook with the same key is replayed")
+	}
+}
+
+func TestContract_V1BulkSubscriptions(t *testing.T) {
+	subscriptions := &subscriptionClientMock{}
+	tokens := auth.NewTokens([]byte("0123456789abcdef0123456789abcdef"), time.Hour, 24*time.Hour, false)
+	handlers := []apihandlers.APIHandler{apihandlers.NewSubscribeHandler(nil, subscriptions, nil, tokens)}
+	srv := NewRestServer(config.REST{HandleTimeout: time.Second}, handlers, nil).Handler
+
+	token, err := tokens.Issue("subscriber-id")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	body := `{"daos":["` + testDaoID + `","5a1f0c3e-8f0b-4f43-9a57-1d0b4f9a2c11","` + testDaoID + `"]}`
+	req := httptest.NewRequest(http.MethodPost, "/v1/subscriptions/bulk", strings.NewReader(body))
+	req.Header.Set("Authorization", "Bearer "+token.Value)
+	rec := httptest.NewRecorder()
+	srv.ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d: %s", rec.Code, rec.Body)
+	}
+	if len(subscriptions.subscriberIDs) != 2 {
+		t.Errorf("upstream calls = %v, want 2", subscriptions.subscriberIDs)
+	}
+
+	assertJSONEqualsFile(t, rec.Body.Bytes(), "v1_subscriptions_bulk.json")
+}
+
+func TestContract_V1VoteSignature(t *testing.T) {
+	const (
+		validSig  = "0xa895920f57d89d448b26fdb0d713546d81b9e51de35a74039a779a7461effb3b27dd4b4d35f7e666b2e3aeac4b245a614439241e2c93f25fb088a8f6bf5db6ec1c"
+		otherSig  = "0x4355c47d63924e8a72e509b65029052eb6c299d53a04e167c5775fd466751c9d07299936d304c153f6443dfa05f40ff007d72911b6f72307f996231605b915621c"
+		contract  = "0x"
+		prepareBy = `{"voter":"0xCD2a3d9F938E13CD947Ec05AbC7FE734Df8DD826","choice":1}`
+	)
+
+	tests := []struct {
+		name       string
+		id         string
+		sig        string
+		wantStatus int
+		wantVotes  int
+	}{
+		{"signed by the voter", "0xprepared", validSig, http.StatusOK, 1},
+		{"signed by another key", "0xprepared", otherSig, http.StatusOK, 1},
+		{"unrecoverable signature", "0xprepared", validSig[:len(validSig)-2] + "05", http.StatusBadRequest, 0},
+		{"malformed signature", "0xprepared", "0xzz", http.StatusBadRequest, 0},
+		{"contract wallet signature", "0xprepared", contract, http.StatusOK, 1},
+		{"not prepared by this instance", "0xunknown", otherSig, http.StatusOK, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vc := &voteClientMock{}
+			handlers := []apihandlers.APIHandler{apihandlers.NewProposalHandler(&proposalClientMock{}, vc, &daoClientMock{}, nil, nil, time.Minute)}
+			srv := NewRestServer(config.REST{HandleTimeout: time.Second}, handlers, nil).Handler
+
+			rec := httptest.NewRecorder()
+			srv.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/v1/proposals/"+testProposalID+"/votes/prepare", strings.NewReader(prepareBy)))
+			if rec.Code != http.StatusOK {
+				t.Fatalf("prepare status = %d: %s", rec.Code, rec.Body)
+			}
+
+			rec = httptest.NewRecorder()
+			body := fmt.Sprintf(`{"id":%q,"sig":%q}`, tt.id, tt.sig)
+			srv.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/v1/proposals/votes", strings.NewReader(body)))
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d: %s", rec.Code, tt.wantStatus, rec.Body)
+			}
+			if vc.votes != tt.wantVotes {
+				t.Errorf("upstream votes = %d, want %d", vc.votes, tt.wantVotes)
+			}
+		})
+	}
+}
+
+func TestGateway(t *testing.T) {
+	srv := newTestServer(t)
+
+	tests := []struct {
+		name       string
+		path       string
+		wantStatus int
+		golden     string
+	}{
+		{"dao by id", "/gateway/v1/daos/" + testDaoID, http.StatusOK, "gateway_dao_by_id.json"},
+		{"proposal by id", "/gateway/v1/proposals/" + testProposalID, http.StatusOK, "gateway_proposal_by_id.json"},
+		{"unknown route", "/gateway/v1/unknown", http.StatusNotFound, "v1_not_found.json"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := serve(t, srv, http.MethodGet, tt.path)
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d; body: %s", rec.Code, tt.wantStatus, rec.Body.String())
+			}
+
+			assertJSONEqualsFile(t, rec.Body.Bytes(), tt.golden)
+		})
+	}
+}
+
+func allHandlers() []apihandlers.APIHandler {
+	return []apihandlers.APIHandler{
+		apihandlers.NewDaoHandler(nil, nil, nil, nil, nil, nil, ""),
+		apihandlers.NewProposalHandler(nil, nil, nil, nil, nil, time.Minute),
+		apihandlers.NewSubscribeHandler(nil, nil, nil, nil),
+		apihandlers.NewFeedHandler(nil, ""),
+		apihandlers.NewVotesHandler(nil, nil, nil, nil, nil),
+		apihandlers.NewEnsHandler(nil),
+		apihandlers.NewStatsHandler(nil),
+		apihandlers.NewDelegateHandler(nil, nil, nil),
+		apihandlers.NewUserHandler(nil, nil, nil, nil, nil, nil),
+	}
+}
+
+func TestOpenAPI_CoversAllRoutes(t *testing.T) {
+	router := newRouter(config.REST{HandleTimeout: time.Second}, allHandlers(), http.NotFoundHandler())
+
+	rec := serve(t, router, http.MethodGet, openapi.SpecPath)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusOK)
+	}
+
+	var doc openapi.Document
+	if err := json.Unmarshal(rec.Body.Bytes(), &doc); err != nil {
+		t.Fatalf("unmarshal spec: %v", err)
+	}
+
+	described := make(map[string]bool)
+	for _, op := range doc.Operations() {
+		described[op] = false
+	}
+
+	err := router.Walk(func(route *mux.Route, _ *mux.Router, _ []*mux.Route) error {
+		tpl, err := route.GetPathTemplate()
+		if err != nil {
+			return nil
+		}
+		if !strings.HasPrefix(tpl, "/v1/") && !strings.HasPrefix(tpl, "/v2/") {
+			return nil
+		}
+
+		methods, err := route.GetMethods()
+		if err != nil {
+			return nil
+		}
+
+		for _, method := range methods {
+			op := method + " " + tpl
+			if _, ok := described[op]; !ok {
+				t.Errorf("route %s (%s) has no OpenAPI spec", op, route.GetName())
+
+				continue
+			}
+			described[op] = true
+		}
+
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("walk routes: %v", err)
+	}
+
+	for op, registered := range described {
+		if !registered {
+			t.Errorf("OpenAPI spec describes unknown route %s", op)
+		}
+	}
+}
+
+func TestOpenAPI_DocsScriptIntegrity(t *testing.T) {
+	rec := serve(t, newRouter(config.REST{HandleTimeout: time.Second}, nil, http.NotFoundHandler()), http.MethodGet, openapi.DocsPath)
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("status without integrity = %d, want %d", rec.Code, http.StatusNotFound)
+	}
+
+	cfg := config.REST{HandleTimeout: time.Second, DocsScriptURL: "https://cdn.example/redoc.js", DocsScriptIntegrity: "sha384-abc"}
+	rec = serve(t, newRouter(cfg, nil, http.NotFoundHandler()), http.MethodGet, openapi.DocsPath)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusOK)
+	}
+	if want := `<script src="https://cdn.example/redoc.js" integrity="sha384-abc" crossorigin="anonymous"></script>`; !strings.Contains(rec.Body.String(), want) {
+		t.Errorf("docs page has no pinned script %s:\n%s", want, rec.Body)
+	}
+}
+
+func TestOpenAPI_Schemas(t *testing.T) {
+	doc := openapi.Build(openapi.Info{}, []openapi.Route{
+		{
+			Method:    http.MethodGet,
+			Path:      "/v1/proposals/{id}/votes",
+			Query:     openapi.ParamsOf(proposalforms.GetVotes{}),
+			Paginated: true,
+			Headers:   []string{response.HeaderTotalVp},
+		},
+		{
+			Method: http.MethodPost,
+			Path:   "/v1/subscribe",
+			Body:   subscribeforms.SubscribeRequest{},
+		},
+	})
+
+	op := doc.Paths["/v1/proposals/{id}/votes"]["get"]
+	if op == nil {
+		t.Fatal("operation is not described")
+	}
+
+	headers := op.Responses["200"].Headers
+	for _, name := range []string{response.HeaderTotalCount, response.HeaderCurrentOffset, response.HeaderLimit, response.HeaderTotalVp} {
+		if headers[name] == nil {
+			t.Errorf("header %s is not described", name)
+		}
+	}
+
+	if op.Responses["400"].Content["application/json"].Schema.Ref != "#/components/schemas/ValidationError" {
+		t.Errorf("bad request response should reference ValidationError schema")
+	}
+
+	params := make(map[string]openapi.Parameter)
+	for _, p := range op.Parameters {
+		params[p.In+":"+p.Name] = p
+	}
+	for _, name := range []string{"path:id", "query:voter", "query:query", "query:offset", "query:limit"} {
+		if _, ok := params[name]; !ok {
+			t.Errorf("parameter %s is not described", name)
+		}
+	}
+	if limit := params["query:limit"].Schema; limit == nil || limit.Default != int64(20) || limit.Minimum == nil || *limit.Minimum != 1 {
+		t.Errorf("limit parameter should have default and minimum from the form tags: %+v", limit)
+	}
+
+	body := doc.Components.Schemas["subscribe.SubscribeRequest"]
+	if body == nil {
+		t.Fatal("subscribe request schema is not registered")
+	}
+	if url := body.Properties["webhook_url"]; url == nil || url.Format != "uri" {
+		t.Errorf("webhook_url should have uri format from the validate tag")
+	}
+	if !reflect.DeepEqual(body.Required, []string{"webhook_url"}) {
+		t.Errorf("required fields should come from the validate tag, got %v", body.Required)
+	}
+}
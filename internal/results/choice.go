@@ -0,0 +1,66 @@
+package results
+
+import (
+	"encoding/json"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// ChoiceLabel renders the choice of the vote by the names of the proposal choices: the name of the single choice,
+// the approved names, the names in the ranked order or the names with their shares in percent for weighted and
+// quadratic votes. The raw choice is returned when it does not match the voting type, e.g. for shielded votes.
+func ChoiceLabel(votingType string, choices []string, choice json.RawMessage) string {
+	var names []string
+	switch votingType {
+	case SingleChoice, Basic:
+		var index int
+		if err := json.Unmarshal(choice, &index); err == nil && index >= 1 && index <= len(choices) {
+			return choices[index-1]
+		}
+	case Approval:
+		var approved []int
+		if err := json.Unmarshal(choice, &approved); err == nil {
+			names = choiceNames(approved, choices)
+		}
+		if names != nil {
+			return strings.Join(names, ", ")
+		}
+	case RankedChoice:
+		var ranked []int
+		if err := json.Unmarshal(choice, &ranked); err == nil {
+			names = choiceNames(ranked, choices)
+		}
+		if names != nil {
+			return strings.Join(names, " > ")
+		}
+	case Weighted, Quadratic:
+		weights := weightedChoice(choice, len(choices))
+		if total := sum(weights); total > 0 {
+			for i, weight := range weights {
+				if weight == 0 {
+					continue
+				}
+				percent := strconv.FormatFloat(math.Round(weight/total*10000)/100, 'f', -1, 64)
+				names = append(names, choices[i]+": "+percent+"%")
+			}
+
+			return strings.Join(names, ", ")
+		}
+	}
+
+	return string(choice)
+}
+
+// choiceNames returns the names of the 1-based choices, nil means one of them does not exist.
+func choiceNames(indexes []int, choices []string) []string {
+	names := make([]string, 0, len(indexes))
+	for _, index := range indexes {
+		if index < 1 || index > len(choices) {
+			return nil
+		}
+		names = append(names, choices[index-1])
+	}
+
+	return names
+}
@@ -0,0 +1,35 @@
+package results
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestChoiceLabel(t *testing.T) {
+	choices := []string{"For", "Against", "Abstain"}
+
+	for name, tc := range map[string]struct {
+		votingType string
+		choice     string
+		want       string
+	}{
+		"single-choice":            {SingleChoice, `2`, "Against"},
+		"basic":                    {Basic, `3`, "Abstain"},
+		"approval":                 {Approval, `[1,3]`, "For, Abstain"},
+		"ranked-choice":            {RankedChoice, `[3,1,2]`, "Abstain > For > Against"},
+		"weighted":                 {Weighted, `{"1":3,"2":1}`, "For: 75%, Against: 25%"},
+		"quadratic":                {Quadratic, `{"3":1,"1":2}`, "For: 66.67%, Abstain: 33.33%"},
+		"unknown choice":           {SingleChoice, `4`, "4"},
+		"unknown approved choice":  {Approval, `[1,5]`, "[1,5]"},
+		"empty weights":            {Weighted, `{}`, "{}"},
+		"shielded vote":            {SingleChoice, `"0xencrypted"`, `"0xencrypted"`},
+		"unsupported voting type":  {"custom", `1`, "1"},
+		"choice of the other type": {RankedChoice, `1`, "1"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			if got := ChoiceLabel(tc.votingType, choices, json.RawMessage(tc.choice)); got != tc.want {
+				t.Errorf("ChoiceLabel() = %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
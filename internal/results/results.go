@@ -0,0 +1,214 @@
+// Package results computes the outcome of Snapshot proposals for the supported voting types.
+package results
+
+import (
+	"errors"
+	"math"
+)
+
+const (
+	SingleChoice = "single-choice"
+	Approval     = "approval"
+	Quadratic    = "quadratic"
+	RankedChoice = "ranked-choice"
+	Weighted     = "weighted"
+	Basic        = "basic"
+
+	// ScoresFinal is the scores state of proposals with the scores calculated after the end of the voting.
+	ScoresFinal = "final"
+)
+
+var ErrUnsupportedType = errors.New("unsupported voting type")
+
+// basic proposals have the fixed choices: for, against and abstain
+const (
+	basicFor = iota
+	basicAgainst
+)
+
+type Proposal struct {
+	Type        string
+	Choices     []string
+	Scores      []float64
+	ScoresTotal float64
+	ScoresState string
+	Quorum      float64
+}
+
+type Choice struct {
+	// Index is the 1-based index of the choice as used in the votes
+	Index int
+	Name  string
+	Score float64
+	// Percent is the share of the choice in the range from 0 to 100
+	Percent float64
+}
+
+type Result struct {
+	Type    string
+	Choices []Choice
+	// Winner is nil when there are no votes or the leading choices are tied
+	Winner *Choice
+	Tie    bool
+
+	ScoresTotal   float64
+	Quorum        float64
+	QuorumReached bool
+	// QuorumMissing is the voting power left to reach the quorum
+	QuorumMissing float64
+
+	// Margin is the difference between the scores of the leading choice and the runner-up
+	Margin        float64
+	MarginPercent float64
+
+	// Rounds contains the scores of every instant runoff round, it is set for recomputed ranked-choice proposals only
+	Rounds [][]float64
+	// Recomputed is true when the scores are calculated from the votes
+	Recomputed bool
+}
+
+// NeedsVotes reports whether the scores should be recomputed from the votes. Ranked-choice and quadratic scores
+// can not be checked from the stored scores, so they are recomputed until the storage has the final ones.
+func NeedsVotes(p Proposal) bool {
+	switch p.Type {
+	case RankedChoice, Quadratic:
+		return p.ScoresState != ScoresFinal || len(p.Scores) != len(p.Choices)
+	default:
+		return false
+	}
+}
+
+// Compute returns the outcome of the proposal based on the stored scores.
+func Compute(p Proposal) (Result, error) {
+	if !supported(p.Type) {
+		return Result{}, ErrUnsupportedType
+	}
+
+	scores := make([]float64, len(p.Choices))
+	copy(scores, p.Scores)
+
+	base := p.ScoresTotal
+	if p.Type == RankedChoice || base <= 0 {
+		// the ranked-choice scores are the scores of the final round without exhausted ballots
+		base = sum(scores)
+	}
+
+	res := Result{
+		Type:        p.Type,
+		Choices:     make([]Choice, len(p.Choices)),
+		ScoresTotal: p.ScoresTotal,
+		Quorum:      p.Quorum,
+	}
+	if res.ScoresTotal <= 0 {
+		res.ScoresTotal = sum(scores)
+	}
+
+	for i, name := range p.Choices {
+		res.Choices[i] = Choice{
+			Index:   i + 1,
+			Name:    name,
+			Score:   scores[i],
+			Percent: percent(scores[i], base),
+		}
+	}
+
+	res.QuorumReached = res.ScoresTotal >= p.Quorum
+	res.QuorumMissing = math.Max(0, p.Quorum-res.ScoresTotal)
+
+	candidates := res.Choices
+	if p.Type == Basic && len(candidates) > basicAgainst {
+		// abstain votes count to the quorum only
+		candidates = candidates[basicFor : basicAgainst+1]
+	}
+
+	first, second := leaders(candidates)
+	if first < 0 || candidates[first].Score <= 0 {
+		return res, nil
+	}
+
+	runnerUp := 0.0
+	if second >= 0 {
+		runnerUp = candidates[second].Score
+	}
+
+	res.Margin = candidates[first].Score - runnerUp
+	res.MarginPercent = percent(res.Margin, base)
+	if res.Margin == 0 {
+		res.Tie = true
+
+		return res, nil
+	}
+
+	winner := candidates[first]
+	res.Winner = &winner
+
+	return res, nil
+}
+
+// ComputeFromVotes recomputes the scores of ranked-choice and quadratic proposals from the votes,
+// other voting types are computed from the stored scores.
+func ComputeFromVotes(p Proposal, votes []Vote) (Result, error) {
+	var rounds [][]float64
+	switch p.Type {
+	case RankedChoice:
+		rounds = rankedChoiceRounds(len(p.Choices), votes)
+		p.Scores = rounds[len(rounds)-1]
+	case Quadratic:
+		p.Scores = quadraticScores(len(p.Choices), votes)
+	default:
+		return Compute(p)
+	}
+
+	p.ScoresTotal = totalVp(votes)
+
+	res, err := Compute(p)
+	if err != nil {
+		return Result{}, err
+	}
+
+	res.Rounds = rounds
+	res.Recomputed = true
+
+	return res, nil
+}
+
+func supported(votingType string) bool {
+	switch votingType {
+	case SingleChoice, Approval, Quadratic, RankedChoice, Weighted, Basic:
+		return true
+	default:
+		return false
+	}
+}
+
+// leaders returns the indexes of the choices with the highest and the second highest scores, -1 if missed.
+func leaders(choices []Choice) (int, int) {
+	first, second := -1, -1
+	for i := range choices {
+		switch {
+		case first < 0 || choices[i].Score > choices[first].Score:
+			first, second = i, first
+		case second < 0 || choices[i].Score > choices[second].Score:
+			second = i
+		}
+	}
+
+	return first, second
+}
+
+func percent(value, base float64) float64 {
+	if base <= 0 {
+		return 0
+	}
+
+	return value / base * 100
+}
+
+func sum(values []float64) float64 {
+	var total float64
+	for _, v := range values {
+		total += v
+	}
+
+	return total
+}
@@ -0,0 +1,305 @@
+package results
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"testing"
+)
+
+const epsilon = 1e-6
+
+func TestCompute(t *testing.T) {
+	for name, tc := range map[string]struct {
+		proposal Proposal
+
+		winner        int
+		tie           bool
+		percents      []float64
+		quorumReached bool
+		quorumMissing float64
+		margin        float64
+	}{
+		"single-choice": {
+			proposal: Proposal{
+				Type:        SingleChoice,
+				Choices:     []string{"Yes", "No", "Maybe"},
+				Scores:      []float64{60, 30, 10},
+				ScoresTotal: 100,
+				Quorum:      50,
+			},
+			winner:        1,
+			percents:      []float64{60, 30, 10},
+			quorumReached: true,
+			margin:        30,
+		},
+		"single-choice without quorum": {
+			proposal: Proposal{
+				Type:        SingleChoice,
+				Choices:     []string{"Yes", "No"},
+				Scores:      []float64{20, 30},
+				ScoresTotal: 50,
+				Quorum:      80,
+			},
+			winner:        2,
+			percents:      []float64{40, 60},
+			quorumMissing: 30,
+			margin:        10,
+		},
+		"single-choice tie": {
+			proposal: Proposal{
+				Type:        SingleChoice,
+				Choices:     []string{"Yes", "No"},
+				Scores:      []float64{50, 50},
+				ScoresTotal: 100,
+			},
+			tie:           true,
+			percents:      []float64{50, 50},
+			quorumReached: true,
+		},
+		"no votes": {
+			proposal: Proposal{
+				Type:    SingleChoice,
+				Choices: []string{"Yes", "No"},
+				Quorum:  10,
+			},
+			percents:      []float64{0, 0},
+			quorumMissing: 10,
+		},
+		"approval": {
+			proposal: Proposal{
+				Type:        Approval,
+				Choices:     []string{"A", "B", "C"},
+				Scores:      []float64{80, 50, 20},
+				ScoresTotal: 100,
+			},
+			winner:        1,
+			percents:      []float64{80, 50, 20},
+			quorumReached: true,
+			margin:        30,
+		},
+		"quadratic": {
+			proposal: Proposal{
+				Type:        Quadratic,
+				Choices:     []string{"A", "B"},
+				Scores:      []float64{75, 25},
+				ScoresTotal: 100,
+				ScoresState: ScoresFinal,
+			},
+			winner:        1,
+			percents:      []float64{75, 25},
+			quorumReached: true,
+			margin:        50,
+		},
+		"ranked-choice": {
+			proposal: Proposal{
+				Type:        RankedChoice,
+				Choices:     []string{"A", "B", "C"},
+				Scores:      []float64{0, 55, 35},
+				ScoresTotal: 100,
+				ScoresState: ScoresFinal,
+			},
+			winner: 2,
+			// exhausted ballots are not a part of the final round
+			percents:      []float64{0, 55.0 / 90 * 100, 35.0 / 90 * 100},
+			quorumReached: true,
+			margin:        20,
+		},
+		"weighted": {
+			proposal: Proposal{
+				Type:        Weighted,
+				Choices:     []string{"A", "B", "C"},
+				Scores:      []float64{10, 25, 15},
+				ScoresTotal: 50,
+				Quorum:      50,
+			},
+			winner:        2,
+			percents:      []float64{20, 50, 30},
+			quorumReached: true,
+			margin:        10,
+		},
+		"basic": {
+			proposal: Proposal{
+				Type:        Basic,
+				Choices:     []string{"For", "Against", "Abstain"},
+				Scores:      []float64{30, 20, 50},
+				ScoresTotal: 100,
+				Quorum:      100,
+			},
+			// abstain does not win
+			winner:        1,
+			percents:      []float64{30, 20, 50},
+			quorumReached: true,
+			margin:        10,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			res, err := Compute(tc.proposal)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			assertResult(t, res, tc.winner, tc.tie, tc.percents)
+			if res.QuorumReached != tc.quorumReached {
+				t.Errorf("expected quorum reached %t, got %t", tc.quorumReached, res.QuorumReached)
+			}
+			if !equal(res.QuorumMissing, tc.quorumMissing) {
+				t.Errorf("expected quorum missing %v, got %v", tc.quorumMissing, res.QuorumMissing)
+			}
+			if !equal(res.Margin, tc.margin) {
+				t.Errorf("expected margin %v, got %v", tc.margin, res.Margin)
+			}
+		})
+	}
+}
+
+func TestCompute_UnsupportedType(t *testing.T) {
+	_, err := Compute(Proposal{Type: "copeland", Choices: []string{"A"}})
+	if !errors.Is(err, ErrUnsupportedType) {
+		t.Fatalf("expected unsupported type error, got %v", err)
+	}
+}
+
+func TestNeedsVotes(t *testing.T) {
+	for name, tc := range map[string]struct {
+		proposal Proposal
+		needs    bool
+	}{
+		"single-choice":            {Proposal{Type: SingleChoice, Choices: []string{"A"}}, false},
+		"pending ranked-choice":    {Proposal{Type: RankedChoice, Choices: []string{"A"}, Scores: []float64{1}, ScoresState: "pending"}, true},
+		"final ranked-choice":      {Proposal{Type: RankedChoice, Choices: []string{"A"}, Scores: []float64{1}, ScoresState: ScoresFinal}, false},
+		"quadratic without scores": {Proposal{Type: Quadratic, Choices: []string{"A"}, ScoresState: ScoresFinal}, true},
+	} {
+		t.Run(name, func(t *testing.T) {
+			if got := NeedsVotes(tc.proposal); got != tc.needs {
+				t.Errorf("expected %t, got %t", tc.needs, got)
+			}
+		})
+	}
+}
+
+func TestComputeFromVotes(t *testing.T) {
+	vote := func(choice string, vp float64) Vote {
+		return Vote{Choice: json.RawMessage(choice), Vp: vp}
+	}
+
+	for name, tc := range map[string]struct {
+		proposal Proposal
+		votes    []Vote
+
+		winner   int
+		tie      bool
+		percents []float64
+		scores   []float64
+		rounds   int
+	}{
+		"ranked-choice with majority in the first round": {
+			proposal: Proposal{Type: RankedChoice, Choices: []string{"A", "B", "C"}},
+			votes: []Vote{
+				vote(`[1,2,3]`, 60),
+				vote(`[2,1,3]`, 40),
+			},
+			winner:   1,
+			percents: []float64{60, 40, 0},
+			scores:   []float64{60, 40, 0},
+			rounds:   1,
+		},
+		"ranked-choice with runoff": {
+			proposal: Proposal{Type: RankedChoice, Choices: []string{"A", "B", "C"}},
+			votes: []Vote{
+				vote(`[1,2,3]`, 40),
+				vote(`[2,1,3]`, 35),
+				vote(`[3,2,1]`, 25),
+			},
+			// C is eliminated and its ballot goes to B
+			winner:   2,
+			percents: []float64{40, 60, 0},
+			scores:   []float64{40, 60, 0},
+			rounds:   2,
+		},
+		"ranked-choice with invalid ranks": {
+			proposal: Proposal{Type: RankedChoice, Choices: []string{"A", "B"}},
+			votes: []Vote{
+				vote(`[5,2,2,1]`, 10),
+				vote(`"bad"`, 100),
+			},
+			winner:   2,
+			percents: []float64{0, 100},
+			scores:   []float64{0, 10},
+			rounds:   1,
+		},
+		"quadratic": {
+			proposal: Proposal{Type: Quadratic, Choices: []string{"A", "B"}},
+			votes: []Vote{
+				vote(`{"1":1}`, 100),
+				vote(`{"2":1}`, 25),
+				vote(`{"2":1}`, 25),
+			},
+			// the sums of roots are 10 and 10, the squares are scaled to the total voting power
+			tie:      true,
+			percents: []float64{50, 50},
+			scores:   []float64{75, 75},
+		},
+		"quadratic with split votes": {
+			proposal: Proposal{Type: Quadratic, Choices: []string{"A", "B"}},
+			votes: []Vote{
+				vote(`{"1":3,"2":1}`, 16),
+				vote(`{"2":1}`, 1),
+			},
+			// the sums of roots are sqrt(12) and sqrt(4)+sqrt(1), so the squares are 12 and 9
+			winner:   1,
+			percents: []float64{12.0 / 21 * 100, 9.0 / 21 * 100},
+			scores:   []float64{12.0 / 21 * 17, 9.0 / 21 * 17},
+		},
+		"single-choice uses stored scores": {
+			proposal: Proposal{Type: SingleChoice, Choices: []string{"A", "B"}, Scores: []float64{1, 3}, ScoresTotal: 4},
+			votes:    []Vote{vote(`1`, 100)},
+			winner:   2,
+			percents: []float64{25, 75},
+			scores:   []float64{1, 3},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			res, err := ComputeFromVotes(tc.proposal, tc.votes)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			assertResult(t, res, tc.winner, tc.tie, tc.percents)
+			for i, score := range tc.scores {
+				if !equal(res.Choices[i].Score, score) {
+					t.Errorf("expected score %v of choice %d, got %v", score, i+1, res.Choices[i].Score)
+				}
+			}
+			if len(res.Rounds) != tc.rounds {
+				t.Errorf("expected %d rounds, got %d", tc.rounds, len(res.Rounds))
+			}
+		})
+	}
+}
+
+func assertResult(t *testing.T, res Result, winner int, tie bool, percents []float64) {
+	t.Helper()
+
+	switch {
+	case winner == 0 && res.Winner != nil:
+		t.Errorf("expected no winner, got %d", res.Winner.Index)
+	case winner != 0 && res.Winner == nil:
+		t.Errorf("expected winner %d, got none", winner)
+	case winner != 0 && res.Winner.Index != winner:
+		t.Errorf("expected winner %d, got %d", winner, res.Winner.Index)
+	}
+	if res.Tie != tie {
+		t.Errorf("expected tie %t, got %t", tie, res.Tie)
+	}
+
+	for i, p := range percents {
+		if !equal(res.Choices[i].Percent, p) {
+			t.Errorf("expected %v percent of choice %d, got %v", p, i+1, res.Choices[i].Percent)
+		}
+	}
+}
+
+func equal(a, b float64) bool {
+	return math.Abs(a-b) < epsilon
+}
@@ -0,0 +1,96 @@
+package results
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+var ErrInvalidChoice = errors.New("invalid choice")
+
+// Apply adds the vote to the stored scores of the proposal, the previous vote of the same voter is removed first.
+// Ranked-choice and quadratic scores can not be updated by one vote, they are recomputed from all votes, see
+// ComputeFromVotes.
+func Apply(p Proposal, vote Vote, previous *Vote) (Proposal, error) {
+	if !supported(p.Type) {
+		return Proposal{}, ErrUnsupportedType
+	}
+
+	scores := make([]float64, len(p.Choices))
+	copy(scores, p.Scores)
+	p.Scores = scores
+
+	if previous != nil {
+		// the previous vote is valid for the proposal, otherwise it would not be accepted
+		if parts, err := voteParts(p.Type, len(p.Choices), *previous); err == nil {
+			for i, part := range parts {
+				p.Scores[i] -= part
+			}
+			p.ScoresTotal -= previous.Vp
+		}
+	}
+
+	parts, err := voteParts(p.Type, len(p.Choices), vote)
+	if err != nil {
+		return Proposal{}, err
+	}
+	for i, part := range parts {
+		p.Scores[i] += part
+	}
+	p.ScoresTotal += vote.Vp
+
+	return p, nil
+}
+
+// ValidChoice reports whether the choice has the format of the voting type and points to existing choices.
+func ValidChoice(votingType string, choices int, choice json.RawMessage) bool {
+	switch votingType {
+	case RankedChoice:
+		ranked := rankedChoice(choice, choices)
+
+		return len(ranked) == choices
+	case Quadratic:
+		return sum(weightedChoice(choice, choices)) > 0
+	default:
+		_, err := voteParts(votingType, choices, Vote{Choice: choice, Vp: 1})
+
+		return err == nil
+	}
+}
+
+// voteParts returns the voting power added to every choice by the vote.
+func voteParts(votingType string, choices int, vote Vote) ([]float64, error) {
+	parts := make([]float64, choices)
+
+	switch votingType {
+	case SingleChoice, Basic:
+		var choice int
+		if err := json.Unmarshal(vote.Choice, &choice); err != nil || choice < 1 || choice > choices {
+			return nil, ErrInvalidChoice
+		}
+		parts[choice-1] = vote.Vp
+	case Approval:
+		var approved []int
+		if err := json.Unmarshal(vote.Choice, &approved); err != nil {
+			return nil, ErrInvalidChoice
+		}
+		for _, choice := range approved {
+			if choice < 1 || choice > choices {
+				return nil, ErrInvalidChoice
+			}
+			parts[choice-1] = vote.Vp
+		}
+	case Weighted:
+		weights := weightedChoice(vote.Choice, choices)
+		total := sum(weights)
+		if total <= 0 {
+			return nil, ErrInvalidChoice
+		}
+		for i, weight := range weights {
+			parts[i] = vote.Vp * weight / total
+		}
+	default:
+		return nil, ErrUnsupportedType
+	}
+
+	return parts, nil
+}
@@ -0,0 +1,105 @@
+package results
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestApply(t *testing.T) {
+	for name, tc := range map[string]struct {
+		proposal Proposal
+		vote     Vote
+		previous *Vote
+
+		scores []float64
+		total  float64
+		err    error
+	}{
+		"single-choice": {
+			proposal: Proposal{Type: SingleChoice, Choices: []string{"A", "B"}, Scores: []float64{10, 20}, ScoresTotal: 30},
+			vote:     Vote{Choice: json.RawMessage(`1`), Vp: 15},
+			scores:   []float64{25, 20},
+			total:    45,
+		},
+		"single-choice replaces the previous vote": {
+			proposal: Proposal{Type: SingleChoice, Choices: []string{"A", "B"}, Scores: []float64{10, 20}, ScoresTotal: 30},
+			vote:     Vote{Choice: json.RawMessage(`1`), Vp: 5},
+			previous: &Vote{Choice: json.RawMessage(`2`), Vp: 5},
+			scores:   []float64{15, 15},
+			total:    30,
+		},
+		"basic": {
+			proposal: Proposal{Type: Basic, Choices: []string{"For", "Against", "Abstain"}, Scores: []float64{1, 2, 3}, ScoresTotal: 6},
+			vote:     Vote{Choice: json.RawMessage(`3`), Vp: 4},
+			scores:   []float64{1, 2, 7},
+			total:    10,
+		},
+		"approval": {
+			proposal: Proposal{Type: Approval, Choices: []string{"A", "B", "C"}, Scores: []float64{10, 0, 5}, ScoresTotal: 10},
+			vote:     Vote{Choice: json.RawMessage(`[2,3]`), Vp: 10},
+			scores:   []float64{10, 10, 15},
+			total:    20,
+		},
+		"weighted": {
+			proposal: Proposal{Type: Weighted, Choices: []string{"A", "B"}, Scores: []float64{10, 10}, ScoresTotal: 20},
+			vote:     Vote{Choice: json.RawMessage(`{"1":3,"2":1}`), Vp: 8},
+			scores:   []float64{16, 12},
+			total:    28,
+		},
+		"choice out of range": {
+			proposal: Proposal{Type: SingleChoice, Choices: []string{"A", "B"}},
+			vote:     Vote{Choice: json.RawMessage(`3`), Vp: 1},
+			err:      ErrInvalidChoice,
+		},
+		"ranked-choice needs votes": {
+			proposal: Proposal{Type: RankedChoice, Choices: []string{"A", "B"}},
+			vote:     Vote{Choice: json.RawMessage(`[1,2]`), Vp: 1},
+			err:      ErrUnsupportedType,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			applied, err := Apply(tc.proposal, tc.vote, tc.previous)
+			if !errors.Is(err, tc.err) {
+				t.Fatalf("expected error %v, got %v", tc.err, err)
+			}
+			if tc.err != nil {
+				return
+			}
+
+			for i, score := range tc.scores {
+				if !equal(applied.Scores[i], score) {
+					t.Errorf("expected score %v of choice %d, got %v", score, i+1, applied.Scores[i])
+				}
+			}
+			if !equal(applied.ScoresTotal, tc.total) {
+				t.Errorf("expected total %v, got %v", tc.total, applied.ScoresTotal)
+			}
+		})
+	}
+}
+
+func TestValidChoice(t *testing.T) {
+	for name, tc := range map[string]struct {
+		votingType string
+		choice     string
+		valid      bool
+	}{
+		"single-choice":              {SingleChoice, `2`, true},
+		"single-choice out of range": {SingleChoice, `4`, false},
+		"approval":                   {Approval, `[1,3]`, true},
+		"approval is not a list":     {Approval, `1`, false},
+		"weighted":                   {Weighted, `{"1":1}`, true},
+		"weighted without weights":   {Weighted, `{"1":0}`, false},
+		"quadratic":                  {Quadratic, `{"2":5}`, true},
+		"ranked-choice":              {RankedChoice, `[3,1,2]`, true},
+		"ranked-choice is partial":   {RankedChoice, `[3,1]`, false},
+		"unsupported type":           {"copeland", `1`, false},
+	} {
+		t.Run(name, func(t *testing.T) {
+			if got := ValidChoice(tc.votingType, 3, json.RawMessage(tc.choice)); got != tc.valid {
+				t.Errorf("expected %t, got %t", tc.valid, got)
+			}
+		})
+	}
+}
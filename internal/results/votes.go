@@ -0,0 +1,146 @@
+package results
+
+import (
+	"encoding/json"
+	"math"
+	"strconv"
+)
+
+type Vote struct {
+	Voter string
+	// Choice is the JSON encoded choice of the vote, its format depends on the voting type
+	Choice json.RawMessage
+	Vp     float64
+}
+
+func totalVp(votes []Vote) float64 {
+	var total float64
+	for _, v := range votes {
+		total += v.Vp
+	}
+
+	return total
+}
+
+// rankedChoiceRounds runs the instant runoff: every round the ballots count to the highest ranked choice
+// still in the race, the choice with the lowest score is eliminated until one of them has the majority.
+func rankedChoiceRounds(choices int, votes []Vote) [][]float64 {
+	ballots := make([][]int, len(votes))
+	for i, v := range votes {
+		ballots[i] = rankedChoice(v.Choice, choices)
+	}
+
+	eliminated := make([]bool, choices)
+	remaining := choices
+
+	var rounds [][]float64
+	for {
+		round := make([]float64, choices)
+		for i, ballot := range ballots {
+			for _, choice := range ballot {
+				if !eliminated[choice] {
+					round[choice] += votes[i].Vp
+
+					break
+				}
+			}
+		}
+		rounds = append(rounds, round)
+
+		total := sum(round)
+		lowest, highest := -1, -1
+		for i := range round {
+			if eliminated[i] {
+				continue
+			}
+			if highest < 0 || round[i] > round[highest] {
+				highest = i
+			}
+			// ties are resolved by eliminating the later choice
+			if lowest < 0 || round[i] <= round[lowest] {
+				lowest = i
+			}
+		}
+
+		if remaining <= 2 || highest < 0 || round[highest] > total/2 {
+			return rounds
+		}
+
+		eliminated[lowest] = true
+		remaining--
+	}
+}
+
+// rankedChoice returns the zero based indexes of the ranked choices, invalid and repeated indexes are skipped.
+func rankedChoice(data json.RawMessage, choices int) []int {
+	var ranked []int
+	if err := json.Unmarshal(data, &ranked); err != nil {
+		return nil
+	}
+
+	seen := make(map[int]bool, len(ranked))
+	result := make([]int, 0, len(ranked))
+	for _, choice := range ranked {
+		if choice < 1 || choice > choices || seen[choice] {
+			continue
+		}
+		seen[choice] = true
+		result = append(result, choice-1)
+	}
+
+	return result
+}
+
+// quadraticScores spreads the voting power of every vote by the weights of the choices, sums square roots
+// of the parts by choices and scales the squares of the sums to the total voting power.
+func quadraticScores(choices int, votes []Vote) []float64 {
+	roots := make([]float64, choices)
+	for _, v := range votes {
+		weights := weightedChoice(v.Choice, choices)
+		total := sum(weights)
+		if total <= 0 {
+			continue
+		}
+
+		for i, weight := range weights {
+			roots[i] += math.Sqrt(weight / total * v.Vp)
+		}
+	}
+
+	squares := make([]float64, choices)
+	for i, root := range roots {
+		squares[i] = root * root
+	}
+
+	scores := make([]float64, choices)
+	squaresTotal := sum(squares)
+	if squaresTotal <= 0 {
+		return scores
+	}
+
+	vp := totalVp(votes)
+	for i, square := range squares {
+		scores[i] = square / squaresTotal * vp
+	}
+
+	return scores
+}
+
+// weightedChoice returns the weights by zero based choice indexes, invalid and negative weights are skipped.
+func weightedChoice(data json.RawMessage, choices int) []float64 {
+	var weighted map[string]float64
+	if err := json.Unmarshal(data, &weighted); err != nil {
+		return nil
+	}
+
+	weights := make([]float64, choices)
+	for key, weight := range weighted {
+		choice, err := strconv.Atoi(key)
+		if err != nil || choice < 1 || choice > choices || weight < 0 {
+			continue
+		}
+		weights[choice-1] = weight
+	}
+
+	return weights
+}
@@ -0,0 +1,83 @@
+// Package address validates Ethereum addresses and renders them in the EIP-55 checksum form.
+package address
+
+import (
+	"encoding/hex"
+	"errors"
+	"strings"
+
+	"golang.org/x/crypto/sha3"
+)
+
+const hexLength = 40
+
+var (
+	ErrInvalidAddress  = errors.New("invalid address")
+	ErrInvalidChecksum = errors.New("invalid address checksum")
+)
+
+// Address is the EIP-55 checksummed address.
+type Address string
+
+// Parse accepts the 0x or 0X prefixed address. Mixed case addresses must have the valid EIP-55 checksum,
+// lower and upper case ones are not checked.
+func Parse(s string) (Address, error) {
+	s = strings.TrimSpace(s)
+	if !IsHex(s) {
+		return "", ErrInvalidAddress
+	}
+
+	checksummed := checksum(s[2:])
+	digits := s[2:]
+	if digits != strings.ToLower(digits) && digits != strings.ToUpper(digits) && digits != checksummed[2:] {
+		return "", ErrInvalidChecksum
+	}
+
+	return Address(checksummed), nil
+}
+
+// Checksum returns the EIP-55 form of the hex address, other values are returned as is.
+func Checksum(s string) string {
+	if !IsHex(s) {
+		return s
+	}
+
+	return checksum(s[2:])
+}
+
+// IsHex reports whether s is the 0x or 0X prefixed 20 bytes hex string.
+func IsHex(s string) bool {
+	if len(s) != hexLength+2 || (s[:2] != "0x" && s[:2] != "0X") {
+		return false
+	}
+
+	_, err := hex.DecodeString(s[2:])
+
+	return err == nil
+}
+
+func (a Address) String() string {
+	return string(a)
+}
+
+func checksum(digits string) string {
+	lower := strings.ToLower(digits)
+
+	h := sha3.NewLegacyKeccak256()
+	h.Write([]byte(lower))
+	hash := h.Sum(nil)
+
+	result := []byte("0x" + lower)
+	for i := 0; i < hexLength; i++ {
+		c := result[i+2]
+		nibble := hash[i/2]
+		if i%2 == 0 {
+			nibble >>= 4
+		}
+		if c >= 'a' && c <= 'f' && nibble&0x0f >= 8 {
+			result[i+2] = c - 'a' + 'A'
+		}
+	}
+
+	return string(result)
+}
@@ -0,0 +1,66 @@
+package address
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+// checksummed addresses from the EIP-55 specification
+var eip55Vectors = []string{
+	"0x52908400098527886E0F7030069857D2E4169EE7",
+	"0x8617E340B3D01FA5F11F306F4090FD50E238070D",
+	"0xde709f2102306220921060314715629080e2fb77",
+	"0x27b1fdb04752bbc536007a920d24acb045561c26",
+	"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed",
+	"0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359",
+	"0xdbF03B407c01E7cD3CBea99509d93f8DDDC8C6FB",
+	"0xD1220A0cf47c7B9Be7A2E6BA89F429762e7b9aDb",
+}
+
+func TestChecksum(t *testing.T) {
+	for _, want := range eip55Vectors {
+		t.Run(want, func(t *testing.T) {
+			if got := Checksum(strings.ToLower(want)); got != want {
+				t.Errorf("Checksum(lower) = %s, want %s", got, want)
+			}
+			if got := Checksum("0X" + strings.ToUpper(want[2:])); got != want {
+				t.Errorf("Checksum(upper) = %s, want %s", got, want)
+			}
+		})
+	}
+
+	if got := Checksum("aci.eth"); got != "aci.eth" {
+		t.Errorf("Checksum(aci.eth) = %s, want unchanged", got)
+	}
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    Address
+		wantErr error
+	}{
+		{"checksummed", "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", nil},
+		{"lowercase", "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed", "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", nil},
+		{"uppercase prefix", "0X5AAEB6053F3E94C9B9A09F33669435E7EF1BEAED", "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", nil},
+		{"with whitespace", " 0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed ", "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", nil},
+		{"wrong checksum", "0x5AAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", "", ErrInvalidChecksum},
+		{"short", "0x5aaeb6053f3e94c9b9a09f33669435e7ef1bea", "", ErrInvalidAddress},
+		{"not hex", "0xZZaeb6053f3e94c9b9a09f33669435e7ef1beaed", "", ErrInvalidAddress},
+		{"ens name", "aci.eth", "", ErrInvalidAddress},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse(tt.input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Parse() error = %v, want %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Parse() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
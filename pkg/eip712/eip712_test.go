@@ -0,0 +1,290 @@
+package eip712
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+)
+
+// mailTypedData is the example from the EIP-712 specification
+const mailTypedData = `{
+	"types": {
+		"EIP712Domain": [
+			{"name": "name", "type": "string"},
+			{"name": "version", "type": "string"},
+			{"name": "chainId", "type": "uint256"},
+			{"name": "verifyingContract", "type": "address"}
+		],
+		"Person": [
+			{"name": "name", "type": "string"},
+			{"name": "wallet", "type": "address"}
+		],
+		"Mail": [
+			{"name": "from", "type": "Person"},
+			{"name": "to", "type": "Person"},
+			{"name": "contents", "type": "string"}
+		]
+	},
+	"primaryType": "Mail",
+	"domain": {
+		"name": "Ether Mail",
+		"version": "1",
+		"chainId": 1,
+		"verifyingContract": "0xCcCCccccCCCCcCCCCCCcCcCccCcCCCcCcccccccC"
+	},
+	"message": {
+		"from": {"name": "Cow", "wallet": "0xCD2a3d9F938E13CD947Ec05AbC7FE734Df8DD826"},
+		"to": {"name": "Bob", "wallet": "0xbBbBBBBbbBBBbbbBbbBbbbbBBbBbbbbBbBbbBBbB"},
+		"contents": "Hello, Bob!"
+	}
+}`
+
+const (
+	mailHash      = "be609aee343fb3c4b28e1df9e632fca64fcfaede20f02e86244efddf30957bd2"
+	mailSignature = "0x4355c47d63924e8a72e509b65029052eb6c299d53a04e167c5775fd466751c9d07299936d304c153f6443dfa05f40ff007d72911b6f72307f996231605b915621c"
+	mailSigner    = "0xcd2a3d9f938e13cd947ec05abc7fe734df8dd826"
+)
+
+// snapshotVoteTypedData is the vote prepared by Snapshot, signed by the private key 0x01
+const snapshotVoteTypedData = `{
+	"types": {
+		"Vote": [
+			{"name": "from", "type": "address"},
+			{"name": "space", "type": "string"},
+			{"name": "timestamp", "type": "uint64"},
+			{"name": "proposal", "type": "bytes32"},
+			{"name": "choice", "type": "uint32"},
+			{"name": "reason", "type": "string"},
+			{"name": "app", "type": "string"},
+			{"name": "metadata", "type": "string"}
+		]
+	},
+	"domain": {"name": "snapshot", "version": "0.1.4"},
+	"message": {
+		"from": "0x7E5F4552091A69125d5DfCb7b8C2659029395Bdf",
+		"space": "aave.eth",
+		"timestamp": 1700000000,
+		"proposal": "0x3c6ee1b4a6ddee5f0a3b9e0a6b22bb7a2e1fa6e4e1d5c1d0b1a4d0c5e6f7a8b9",
+		"choice": 1,
+		"reason": "",
+		"app": "goverland",
+		"metadata": "{}"
+	}
+}`
+
+const (
+	snapshotVoteHash      = "8ab76ab62131ac4a5a2fa415a0992b7796478637b895a6268b0582c2e55b9193"
+	snapshotVoteSignature = "0x6c82bb7bdf97fd1b0b8537a0bc7fe78a6a98a5548e9a15df74543e68eba4b29c314b7ee91c9713615dea503925e27c5fe04d892c759c1f26a05f245522f545581b"
+	snapshotVoteSigner    = "0x7e5f4552091a69125d5dfcb7b8c2659029395bdf"
+)
+
+func TestTypedData_Hash(t *testing.T) {
+	td, err := Parse([]byte(mailTypedData))
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+
+	if got := td.encodeType("Mail"); got != "Mail(Person from,Person to,string contents)Person(string name,address wallet)" {
+		t.Errorf("unexpected encoded type: %s", got)
+	}
+
+	hash, err := td.Hash()
+	if err != nil {
+		t.Fatalf("hash: %v", err)
+	}
+	if got := hex.EncodeToString(hash); got != mailHash {
+		t.Errorf("expected hash %s, got %s", mailHash, got)
+	}
+}
+
+func TestTypedData_HashSnapshotVote(t *testing.T) {
+	td, err := Parse([]byte(snapshotVoteTypedData))
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+
+	hash, err := td.Hash()
+	if err != nil {
+		t.Fatalf("hash: %v", err)
+	}
+
+	// the expected hash is encoded by hand: every field is a 32 bytes word, strings are hashed
+	word := func(b ...byte) []byte {
+		return append(make([]byte, 32-len(b)), b...)
+	}
+	str := func(s string) []byte {
+		return keccak256([]byte(s))
+	}
+	from, _ := hex.DecodeString("7e5f4552091a69125d5dfcb7b8c2659029395bdf")
+	proposal, _ := hex.DecodeString("3c6ee1b4a6ddee5f0a3b9e0a6b22bb7a2e1fa6e4e1d5c1d0b1a4d0c5e6f7a8b9")
+	domain := keccak256(str("EIP712Domain(string name,string version)"), str("snapshot"), str("0.1.4"))
+	message := keccak256(
+		str("Vote(address from,string space,uint64 timestamp,bytes32 proposal,uint32 choice,string reason,string app,string metadata)"),
+		word(from...),
+		str("aave.eth"),
+		word(0x65, 0x53, 0xf1, 0x00), // 1700000000
+		proposal,
+		word(1),
+		str(""),
+		str("goverland"),
+		str("{}"),
+	)
+	expected := hex.EncodeToString(keccak256([]byte("\x19\x01"), domain, message))
+	if expected != snapshotVoteHash {
+		t.Fatalf("unexpected hand encoded hash %s", expected)
+	}
+	if got := hex.EncodeToString(hash); got != snapshotVoteHash {
+		t.Errorf("expected hash %s, got %s", snapshotVoteHash, got)
+	}
+
+	sig, err := ParseSignature(snapshotVoteSignature)
+	if err != nil {
+		t.Fatalf("parse signature: %v", err)
+	}
+	signer, err := RecoverAddress(hash, sig)
+	if err != nil {
+		t.Fatalf("recover: %v", err)
+	}
+	if signer != snapshotVoteSigner {
+		t.Errorf("expected signer %s, got %s", snapshotVoteSigner, signer)
+	}
+}
+
+func TestParseInteger(t *testing.T) {
+	for value, expected := range map[string]int64{
+		"42":   42,
+		"0042": 42,
+		"010":  10,
+		"0x10": 16,
+		"0X1f": 31,
+		"-012": -12,
+		"-0x1": -1,
+	} {
+		n, err := parseInteger(value)
+		if err != nil {
+			t.Errorf("%s: %v", value, err)
+
+			continue
+		}
+		if n.Int64() != expected {
+			t.Errorf("%s: expected %d, got %s", value, expected, n)
+		}
+	}
+
+	for _, value := range []string{"0b101", "1_000", "0o17", "0x", "ten"} {
+		if _, err := parseInteger(value); !errors.Is(err, ErrInvalidTypedData) {
+			t.Errorf("%s: expected invalid typed data error, got %v", value, err)
+		}
+	}
+}
+
+func TestParse_DerivesMissedTypes(t *testing.T) {
+	// Snapshot sends neither the domain type nor the primary type
+	td, err := Parse([]byte(`{
+		"types": {
+			"Person": [{"name": "name", "type": "string"}, {"name": "wallet", "type": "address"}],
+			"Mail": [{"name": "from", "type": "Person"}, {"name": "to", "type": "Person"}, {"name": "contents", "type": "string"}]
+		},
+		"domain": {"name": "Ether Mail", "version": "1", "chainId": 1, "verifyingContract": "0xCcCCccccCCCCcCCCCCCcCcCccCcCCCcCcccccccC"},
+		"message": {
+			"from": {"name": "Cow", "wallet": "0xCD2a3d9F938E13CD947Ec05AbC7FE734Df8DD826"},
+			"to": {"name": "Bob", "wallet": "0xbBbBBBBbbBBBbbbBbbBbbbbBBbBbbbbBbBbbBBbB"},
+			"contents": "Hello, Bob!"
+		}
+	}`))
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	if td.PrimaryType != "Mail" {
+		t.Errorf("expected primary type Mail, got %s", td.PrimaryType)
+	}
+
+	hash, err := td.Hash()
+	if err != nil {
+		t.Fatalf("hash: %v", err)
+	}
+	if got := hex.EncodeToString(hash); got != mailHash {
+		t.Errorf("expected hash %s, got %s", mailHash, got)
+	}
+}
+
+func TestParse_Errors(t *testing.T) {
+	for name, data := range map[string]string{
+		"not json":               `{"types":`,
+		"missed message":         `{"types": {"Mail": []}}`,
+		"ambiguous primary type": `{"types": {"A": [], "B": []}, "message": {}}`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			if _, err := Parse([]byte(data)); !errors.Is(err, ErrInvalidTypedData) {
+				t.Errorf("expected invalid typed data error, got %v", err)
+			}
+		})
+	}
+}
+
+func TestRecoverAddress(t *testing.T) {
+	hash, _ := hex.DecodeString(mailHash)
+
+	for name, tc := range map[string]struct {
+		sig    string
+		signer string
+		err    error
+		// mismatch is set when the signature may be recovered to another address or fail
+		mismatch bool
+	}{
+		"known signature": {
+			sig:    mailSignature,
+			signer: mailSigner,
+		},
+		"recovery id without offset": {
+			sig:    mailSignature[:len(mailSignature)-2] + "01",
+			signer: mailSigner,
+		},
+		"tampered signature": {
+			sig:      mailSignature[:10] + "00" + mailSignature[12:],
+			mismatch: true,
+		},
+		"invalid recovery id": {
+			sig: mailSignature[:len(mailSignature)-2] + "05",
+			err: ErrInvalidSignature,
+		},
+		"contract wallet signature": {
+			sig: "0x",
+			err: ErrInvalidSignature,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			sig, err := ParseSignature(tc.sig)
+			if err != nil {
+				t.Fatalf("parse signature: %v", err)
+			}
+
+			signer, err := RecoverAddress(hash, sig)
+			if tc.mismatch {
+				if err == nil && signer == mailSigner {
+					t.Errorf("expected the tampered signature not to match the signer")
+				}
+
+				return
+			}
+			if !errors.Is(err, tc.err) {
+				t.Fatalf("expected error %v, got %v", tc.err, err)
+			}
+			if tc.err != nil {
+				return
+			}
+
+			if signer != tc.signer {
+				t.Errorf("expected signer %s, got %s", tc.signer, signer)
+			}
+		})
+	}
+}
+
+func TestParseSignature(t *testing.T) {
+	for _, sig := range []string{"4355c47d", "0xzz"} {
+		if _, err := ParseSignature(sig); !errors.Is(err, ErrInvalidSignature) {
+			t.Errorf("%s: expected invalid signature error, got %v", sig, err)
+		}
+	}
+}
@@ -0,0 +1,61 @@
+package eip712
+
+import (
+	"encoding/hex"
+	"errors"
+	"strings"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
+)
+
+const signatureLength = 65
+
+var ErrInvalidSignature = errors.New("invalid signature")
+
+// ParseSignature decodes the 0x prefixed hex signature r ‖ s ‖ v.
+func ParseSignature(sig string) ([]byte, error) {
+	if !strings.HasPrefix(sig, "0x") && !strings.HasPrefix(sig, "0X") {
+		return nil, ErrInvalidSignature
+	}
+
+	b, err := hex.DecodeString(sig[2:])
+	if err != nil {
+		return nil, ErrInvalidSignature
+	}
+
+	return b, nil
+}
+
+// IsECDSA reports whether the signature has the length of the secp256k1 signature. Signatures of contract wallets
+// (EIP-1271) have arbitrary length and can not be recovered.
+func IsECDSA(sig []byte) bool {
+	return len(sig) == signatureLength
+}
+
+// RecoverAddress returns the lower case 0x prefixed address of the signer of the hash.
+func RecoverAddress(hash, sig []byte) (string, error) {
+	if len(hash) != 32 || !IsECDSA(sig) {
+		return "", ErrInvalidSignature
+	}
+
+	v := sig[64]
+	if v >= 27 {
+		v -= 27
+	}
+	if v > 1 {
+		return "", ErrInvalidSignature
+	}
+
+	// the compact format is v ‖ r ‖ s with v = 27 + recovery id for uncompressed keys
+	compact := make([]byte, signatureLength)
+	compact[0] = 27 + v
+	copy(compact[1:], sig[:64])
+
+	pub, _, err := ecdsa.RecoverCompact(compact, hash)
+	if err != nil {
+		return "", ErrInvalidSignature
+	}
+
+	// the address is the last 20 bytes of the hash of the uncompressed key without the prefix
+	return "0x" + hex.EncodeToString(keccak256(pub.SerializeUncompressed()[1:])[12:]), nil
+}
@@ -0,0 +1,350 @@
+// Package eip712 hashes EIP-712 typed data and recovers the signer of the hash.
+package eip712
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"golang.org/x/crypto/sha3"
+)
+
+const domainType = "EIP712Domain"
+
+var (
+	ErrInvalidTypedData = errors.New("invalid typed data")
+
+	arrayRegexp = regexp.MustCompile(`^(.+)\[(\d*)\]$`)
+
+	// domainFields are the fields of the domain in the order defined by the standard
+	domainFields = []Type{
+		{Name: "name", Type: "string"},
+		{Name: "version", Type: "string"},
+		{Name: "chainId", Type: "uint256"},
+		{Name: "verifyingContract", Type: "address"},
+		{Name: "salt", Type: "bytes32"},
+	}
+)
+
+type Type struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+type TypedData struct {
+	Types       map[string][]Type      `json:"types"`
+	PrimaryType string                 `json:"primaryType"`
+	Domain      map[string]interface{} `json:"domain"`
+	Message     map[string]interface{} `json:"message"`
+}
+
+// Parse decodes the JSON typed data. The domain type and the primary type are derived when they are missed,
+// as Snapshot does not send them.
+func Parse(data []byte) (TypedData, error) {
+	var td TypedData
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	if err := dec.Decode(&td); err != nil {
+		return TypedData{}, fmt.Errorf("%w: %v", ErrInvalidTypedData, err)
+	}
+
+	if td.Types == nil || td.Message == nil {
+		return TypedData{}, fmt.Errorf("%w: types and message are required", ErrInvalidTypedData)
+	}
+
+	if _, ok := td.Types[domainType]; !ok {
+		fields := make([]Type, 0, len(domainFields))
+		for _, field := range domainFields {
+			if _, ok := td.Domain[field.Name]; ok {
+				fields = append(fields, field)
+			}
+		}
+		td.Types[domainType] = fields
+	}
+
+	if td.PrimaryType == "" {
+		primary, err := primaryType(td.Types)
+		if err != nil {
+			return TypedData{}, err
+		}
+		td.PrimaryType = primary
+	}
+
+	return td, nil
+}
+
+// Hash returns the hash to sign: keccak256("\x19\x01" ‖ domainSeparator ‖ hashStruct(message)).
+func (td TypedData) Hash() ([]byte, error) {
+	domain, err := td.hashStruct(domainType, td.Domain)
+	if err != nil {
+		return nil, fmt.Errorf("hash domain: %w", err)
+	}
+
+	message, err := td.hashStruct(td.PrimaryType, td.Message)
+	if err != nil {
+		return nil, fmt.Errorf("hash message: %w", err)
+	}
+
+	return keccak256([]byte{0x19, 0x01}, domain, message), nil
+}
+
+// primaryType returns the only type which is not referenced by other types.
+func primaryType(types map[string][]Type) (string, error) {
+	referenced := make(map[string]bool)
+	for _, fields := range types {
+		for _, field := range fields {
+			referenced[baseType(field.Type)] = true
+		}
+	}
+
+	var candidates []string
+	for name := range types {
+		if name != domainType && !referenced[name] {
+			candidates = append(candidates, name)
+		}
+	}
+
+	if len(candidates) != 1 {
+		return "", fmt.Errorf("%w: unable to determine the primary type", ErrInvalidTypedData)
+	}
+
+	return candidates[0], nil
+}
+
+func (td TypedData) hashStruct(name string, data map[string]interface{}) ([]byte, error) {
+	encoded, err := td.encodeData(name, data)
+	if err != nil {
+		return nil, err
+	}
+
+	return keccak256(encoded), nil
+}
+
+func (td TypedData) encodeData(name string, data map[string]interface{}) ([]byte, error) {
+	fields, ok := td.Types[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: unknown type %s", ErrInvalidTypedData, name)
+	}
+
+	buf := bytes.NewBuffer(keccak256([]byte(td.encodeType(name))))
+	for _, field := range fields {
+		value, err := td.encodeValue(field.Type, data[field.Name])
+		if err != nil {
+			return nil, fmt.Errorf("field %s: %w", field.Name, err)
+		}
+		buf.Write(value)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// encodeType returns the type with the referenced types sorted by name, e.g. Mail(Person from)Person(string name).
+func (td TypedData) encodeType(name string) string {
+	deps := td.dependencies(name, map[string]bool{})
+	delete(deps, name)
+
+	sorted := make([]string, 0, len(deps))
+	for dep := range deps {
+		sorted = append(sorted, dep)
+	}
+	sort.Strings(sorted)
+
+	var sb strings.Builder
+	for _, typ := range append([]string{name}, sorted...) {
+		fields := make([]string, len(td.Types[typ]))
+		for i, field := range td.Types[typ] {
+			fields[i] = field.Type + " " + field.Name
+		}
+		sb.WriteString(typ + "(" + strings.Join(fields, ",") + ")")
+	}
+
+	return sb.String()
+}
+
+func (td TypedData) dependencies(name string, found map[string]bool) map[string]bool {
+	if found[name] {
+		return found
+	}
+	if _, ok := td.Types[name]; !ok {
+		return found
+	}
+
+	found[name] = true
+	for _, field := range td.Types[name] {
+		td.dependencies(baseType(field.Type), found)
+	}
+
+	return found
+}
+
+func (td TypedData) encodeValue(typ string, value interface{}) ([]byte, error) {
+	if match := arrayRegexp.FindStringSubmatch(typ); match != nil {
+		items, ok := value.([]interface{})
+		if !ok {
+			return nil, fmt.Errorf("%w: %s should be an array", ErrInvalidTypedData, typ)
+		}
+		if match[2] != "" && strconv.Itoa(len(items)) != match[2] {
+			return nil, fmt.Errorf("%w: %s has %d items", ErrInvalidTypedData, typ, len(items))
+		}
+
+		var buf bytes.Buffer
+		for _, item := range items {
+			encoded, err := td.encodeValue(match[1], item)
+			if err != nil {
+				return nil, err
+			}
+			buf.Write(encoded)
+		}
+
+		return keccak256(buf.Bytes()), nil
+	}
+
+	if _, ok := td.Types[typ]; ok {
+		data, ok := value.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("%w: %s should be an object", ErrInvalidTypedData, typ)
+		}
+
+		return td.hashStruct(typ, data)
+	}
+
+	return encodeAtomic(typ, value)
+}
+
+func encodeAtomic(typ string, value interface{}) ([]byte, error) {
+	switch {
+	case typ == "string":
+		s, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("%w: string expected", ErrInvalidTypedData)
+		}
+
+		return keccak256([]byte(s)), nil
+	case typ == "bytes":
+		b, err := decodeHex(value)
+		if err != nil {
+			return nil, err
+		}
+
+		return keccak256(b), nil
+	case typ == "bool":
+		b, ok := value.(bool)
+		if !ok {
+			return nil, fmt.Errorf("%w: bool expected", ErrInvalidTypedData)
+		}
+		if b {
+			return leftPad(big.NewInt(1).Bytes()), nil
+		}
+
+		return leftPad(nil), nil
+	case typ == "address":
+		b, err := decodeHex(value)
+		if err != nil || len(b) != 20 {
+			return nil, fmt.Errorf("%w: address expected", ErrInvalidTypedData)
+		}
+
+		return leftPad(b), nil
+	case strings.HasPrefix(typ, "bytes"):
+		size, err := strconv.Atoi(strings.TrimPrefix(typ, "bytes"))
+		if err != nil || size < 1 || size > 32 {
+			return nil, fmt.Errorf("%w: unknown type %s", ErrInvalidTypedData, typ)
+		}
+		b, err := decodeHex(value)
+		if err != nil || len(b) > size {
+			return nil, fmt.Errorf("%w: %s expected", ErrInvalidTypedData, typ)
+		}
+
+		return append(b, make([]byte, 32-len(b))...), nil
+	case strings.HasPrefix(typ, "uint"), strings.HasPrefix(typ, "int"):
+		n, err := parseInteger(value)
+		if err != nil {
+			return nil, err
+		}
+		if n.Sign() < 0 {
+			// two's complement in 256 bits
+			n = new(big.Int).Add(n, new(big.Int).Lsh(big.NewInt(1), 256))
+		}
+		if n.BitLen() > 256 {
+			return nil, fmt.Errorf("%w: %s overflow", ErrInvalidTypedData, typ)
+		}
+
+		return leftPad(n.Bytes()), nil
+	default:
+		return nil, fmt.Errorf("%w: unknown type %s", ErrInvalidTypedData, typ)
+	}
+}
+
+func parseInteger(value interface{}) (*big.Int, error) {
+	var s string
+	switch v := value.(type) {
+	case json.Number:
+		s = v.String()
+	case string:
+		s = v
+	case float64:
+		s = strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return nil, fmt.Errorf("%w: integer expected", ErrInvalidTypedData)
+	}
+
+	// only the hex prefix is accepted, decimals with leading zeros are not octal
+	sign, digits, base := "", s, 10
+	if strings.HasPrefix(digits, "-") {
+		sign, digits = "-", digits[1:]
+	}
+	if strings.HasPrefix(digits, "0x") || strings.HasPrefix(digits, "0X") {
+		digits, base = digits[2:], 16
+	}
+
+	n, ok := new(big.Int).SetString(sign+digits, base)
+	if !ok || digits == "" {
+		return nil, fmt.Errorf("%w: integer expected", ErrInvalidTypedData)
+	}
+
+	return n, nil
+}
+
+func decodeHex(value interface{}) ([]byte, error) {
+	s, ok := value.(string)
+	if !ok || !strings.HasPrefix(s, "0x") && !strings.HasPrefix(s, "0X") {
+		return nil, fmt.Errorf("%w: hex string expected", ErrInvalidTypedData)
+	}
+
+	b, err := hex.DecodeString(s[2:])
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidTypedData, err)
+	}
+
+	return b, nil
+}
+
+func baseType(typ string) string {
+	for {
+		match := arrayRegexp.FindStringSubmatch(typ)
+		if match == nil {
+			return typ
+		}
+		typ = match[1]
+	}
+}
+
+func leftPad(b []byte) []byte {
+	return append(make([]byte, 32-len(b)), b...)
+}
+
+func keccak256(data ...[]byte) []byte {
+	h := sha3.NewLegacyKeccak256()
+	for _, d := range data {
+		h.Write(d)
+	}
+
+	return h.Sum(nil)
+}
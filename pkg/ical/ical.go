@@ -0,0 +1,122 @@
+// Package ical renders RFC 5545 calendars with events.
+package ical
+
+import (
+	"bufio"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	ContentType = "text/calendar"
+
+	// maxLineLength is the max length of the content line in octets, longer lines are folded
+	maxLineLength = 75
+	dateTimeUTC   = "20060102T150405Z"
+)
+
+var textEscaper = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`)
+
+type Calendar struct {
+	// ProdID identifies the product created the calendar
+	ProdID string
+	// Name is shown by the calendar clients as the name of the subscribed calendar
+	Name   string
+	Events []Event
+}
+
+type Event struct {
+	// UID is the permanent identifier of the event, clients update the event with the same UID in place
+	UID         string
+	Summary     string
+	Description string
+	URL         string
+	Start       time.Time
+	End         time.Time
+	// Stamp is the time the event was created or changed the last time
+	Stamp time.Time
+	// Sequence is the revision of the event, clients apply the changes of the event with the greater sequence only
+	Sequence int64
+}
+
+// Write writes the calendar with CRLF line breaks.
+func Write(w io.Writer, c Calendar) error {
+	lw := &lineWriter{w: bufio.NewWriter(w)}
+
+	lw.line("BEGIN", "VCALENDAR")
+	lw.line("VERSION", "2.0")
+	lw.line("PRODID", c.ProdID)
+	lw.line("CALSCALE", "GREGORIAN")
+	lw.line("METHOD", "PUBLISH")
+	if c.Name != "" {
+		lw.line("X-WR-CALNAME", escapeText(c.Name))
+	}
+
+	for _, e := range c.Events {
+		lw.line("BEGIN", "VEVENT")
+		lw.line("UID", e.UID)
+		lw.line("SEQUENCE", strconv.FormatInt(e.Sequence, 10))
+		lw.line("DTSTAMP", formatTime(e.Stamp))
+		lw.line("LAST-MODIFIED", formatTime(e.Stamp))
+		lw.line("DTSTART", formatTime(e.Start))
+		lw.line("DTEND", formatTime(e.End))
+		lw.line("SUMMARY", escapeText(e.Summary))
+		if e.Description != "" {
+			lw.line("DESCRIPTION", escapeText(e.Description))
+		}
+		if e.URL != "" {
+			lw.line("URL", e.URL)
+		}
+		lw.line("END", "VEVENT")
+	}
+
+	lw.line("END", "VCALENDAR")
+	if lw.err != nil {
+		return lw.err
+	}
+
+	return lw.w.Flush()
+}
+
+func formatTime(t time.Time) string {
+	return t.UTC().Format(dateTimeUTC)
+}
+
+func escapeText(value string) string {
+	return textEscaper.Replace(value)
+}
+
+// lineWriter writes the content lines folding them by maxLineLength octets, the first error is kept.
+type lineWriter struct {
+	w   *bufio.Writer
+	err error
+}
+
+func (l *lineWriter) line(name, value string) {
+	if l.err != nil {
+		return
+	}
+
+	var b strings.Builder
+	line, limit := name+":"+value, maxLineLength
+	for len(line) > limit {
+		// lines are folded between characters, not in the middle of UTF-8 sequences
+		cut := limit
+		for !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+
+		b.WriteString(line[:cut])
+		b.WriteString("\r\n ")
+		line = line[cut:]
+		// the continuation lines start with the space, so they have one octet less for the content
+		limit = maxLineLength - 1
+	}
+	b.WriteString(line)
+	b.WriteString("\r\n")
+
+	_, l.err = l.w.WriteString(b.String())
+}
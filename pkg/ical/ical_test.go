@@ -0,0 +1,83 @@
+package ical
+
+import (
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+)
+
+func TestWrite(t *testing.T) {
+	var buf strings.Builder
+	err := Write(&buf, Calendar{
+		ProdID: "-//Goverland//Core Web API//EN",
+		Name:   "Aave, proposals",
+		Events: []Event{{
+			UID:         "0x1@goverland.xyz",
+			Summary:     "Enable stable rate; v2",
+			Description: "Vote:\nhttps://snapshot.org/#/aave.eth/proposal/0x1",
+			URL:         "https://snapshot.org/#/aave.eth/proposal/0x1",
+			Start:       time.Date(2024, 2, 1, 12, 0, 0, 0, time.UTC),
+			End:         time.Date(2024, 2, 4, 12, 0, 0, 0, time.FixedZone("CET", 3600)),
+			Stamp:       time.Date(2024, 2, 2, 12, 0, 0, 0, time.UTC),
+			Sequence:    86400,
+		}},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := strings.Join([]string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"PRODID:-//Goverland//Core Web API//EN",
+		"CALSCALE:GREGORIAN",
+		"METHOD:PUBLISH",
+		`X-WR-CALNAME:Aave\, proposals`,
+		"BEGIN:VEVENT",
+		"UID:0x1@goverland.xyz",
+		"SEQUENCE:86400",
+		"DTSTAMP:20240202T120000Z",
+		"LAST-MODIFIED:20240202T120000Z",
+		"DTSTART:20240201T120000Z",
+		"DTEND:20240204T110000Z",
+		`SUMMARY:Enable stable rate\; v2`,
+		`DESCRIPTION:Vote:\nhttps://snapshot.org/#/aave.eth/proposal/0x1`,
+		"URL:https://snapshot.org/#/aave.eth/proposal/0x1",
+		"END:VEVENT",
+		"END:VCALENDAR",
+		"",
+	}, "\r\n")
+	if got := buf.String(); got != want {
+		t.Errorf("Write() =\n%q\nwant\n%q", got, want)
+	}
+}
+
+func TestWrite_FoldsLongLines(t *testing.T) {
+	summary := strings.Repeat("голосование ", 20)
+
+	var buf strings.Builder
+	if err := Write(&buf, Calendar{Events: []Event{{Summary: summary}}}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var unfolded strings.Builder
+	for _, line := range strings.Split(strings.TrimSuffix(buf.String(), "\r\n"), "\r\n") {
+		if len(line) > maxLineLength {
+			t.Errorf("line %q is longer than %d octets", line, maxLineLength)
+		}
+		if !utf8.ValidString(line) {
+			t.Errorf("line %q splits the UTF-8 sequence", line)
+		}
+
+		if strings.HasPrefix(line, " ") {
+			unfolded.WriteString(line[1:])
+		} else {
+			unfolded.WriteString("\n" + line)
+		}
+	}
+
+	if !strings.Contains(unfolded.String(), "\nSUMMARY:"+summary+"\n") {
+		t.Errorf("unfolded calendar does not contain the summary:\n%s", unfolded.String())
+	}
+}
@@ -0,0 +1,211 @@
+// Package syndication renders feeds in the Atom 1.0 and RSS 2.0 formats.
+package syndication
+
+import (
+	"encoding/xml"
+	"io"
+	"time"
+)
+
+const (
+	ContentTypeAtom = "application/atom+xml"
+	ContentTypeRSS  = "application/rss+xml"
+
+	atomNamespace = "http://www.w3.org/2005/Atom"
+)
+
+type Feed struct {
+	// ID is the permanent IRI of the feed, e.g. urn:uuid:...
+	ID          string
+	Title       string
+	Description string
+	// Self is the URL of the feed itself
+	Self string
+	// Link is the URL of the page the feed belongs to, Self is used when it is empty
+	Link    string
+	Author  string
+	Updated time.Time
+	Entries []Entry
+}
+
+type Entry struct {
+	// ID is the permanent IRI of the entry, it is used as the RSS guid
+	ID         string
+	Title      string
+	Link       string
+	Author     string
+	Summary    string
+	Categories []string
+	Published  time.Time
+	Updated    time.Time
+}
+
+func (f Feed) link() string {
+	if f.Link != "" {
+		return f.Link
+	}
+
+	return f.Self
+}
+
+// WriteAtom writes the feed as Atom 1.0 document.
+func WriteAtom(w io.Writer, f Feed) error {
+	doc := atomFeed{
+		ID:       f.ID,
+		Title:    f.Title,
+		Subtitle: f.Description,
+		Updated:  atomTime(f.Updated),
+		Entries:  make([]atomEntry, len(f.Entries)),
+	}
+	if f.Self != "" {
+		doc.Links = append(doc.Links, atomLink{Rel: "self", Type: ContentTypeAtom, Href: f.Self})
+	}
+	if f.Link != "" {
+		doc.Links = append(doc.Links, atomLink{Rel: "alternate", Href: f.Link})
+	}
+	if f.Author != "" {
+		doc.Author = &atomPerson{Name: f.Author}
+	}
+
+	for i, e := range f.Entries {
+		entry := atomEntry{
+			ID:        e.ID,
+			Title:     e.Title,
+			Updated:   atomTime(e.Updated),
+			Published: atomTime(e.Published),
+			Summary:   e.Summary,
+		}
+		if e.Link != "" {
+			entry.Links = []atomLink{{Rel: "alternate", Href: e.Link}}
+		}
+		if e.Author != "" {
+			entry.Author = &atomPerson{Name: e.Author}
+		}
+		for _, category := range e.Categories {
+			entry.Categories = append(entry.Categories, atomCategory{Term: category})
+		}
+		doc.Entries[i] = entry
+	}
+
+	return write(w, doc)
+}
+
+// WriteRSS writes the feed as RSS 2.0 document, the self link is added in the Atom namespace.
+func WriteRSS(w io.Writer, f Feed) error {
+	channel := rssChannel{
+		Title:         f.Title,
+		Link:          f.link(),
+		Description:   f.Description,
+		LastBuildDate: rssTime(f.Updated),
+		Items:         make([]rssItem, len(f.Entries)),
+	}
+	if f.Self != "" {
+		channel.Self = &atomLink{Rel: "self", Type: ContentTypeRSS, Href: f.Self}
+	}
+
+	for i, e := range f.Entries {
+		channel.Items[i] = rssItem{
+			Title:       e.Title,
+			Link:        e.Link,
+			GUID:        rssGUID{IsPermaLink: false, Value: e.ID},
+			PubDate:     rssTime(e.Updated),
+			Description: e.Summary,
+			Categories:  e.Categories,
+		}
+	}
+
+	return write(w, rssDocument{Version: "2.0", AtomNamespace: atomNamespace, Channel: channel})
+}
+
+func write(w io.Writer, doc any) error {
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(doc); err != nil {
+		return err
+	}
+	if err := enc.Close(); err != nil {
+		return err
+	}
+
+	_, err := io.WriteString(w, "\n")
+
+	return err
+}
+
+func atomTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
+
+func rssTime(t time.Time) string {
+	return t.UTC().Format(time.RFC1123Z)
+}
+
+type atomFeed struct {
+	XMLName  xml.Name    `xml:"http://www.w3.org/2005/Atom feed"`
+	ID       string      `xml:"id"`
+	Title    string      `xml:"title"`
+	Subtitle string      `xml:"subtitle,omitempty"`
+	Updated  string      `xml:"updated"`
+	Links    []atomLink  `xml:"link"`
+	Author   *atomPerson `xml:"author,omitempty"`
+	Entries  []atomEntry `xml:"entry"`
+}
+
+type atomEntry struct {
+	ID         string         `xml:"id"`
+	Title      string         `xml:"title"`
+	Updated    string         `xml:"updated"`
+	Published  string         `xml:"published"`
+	Links      []atomLink     `xml:"link"`
+	Author     *atomPerson    `xml:"author,omitempty"`
+	Categories []atomCategory `xml:"category"`
+	Summary    string         `xml:"summary,omitempty"`
+}
+
+type atomLink struct {
+	Rel  string `xml:"rel,attr,omitempty"`
+	Type string `xml:"type,attr,omitempty"`
+	Href string `xml:"href,attr"`
+}
+
+type atomPerson struct {
+	Name string `xml:"name"`
+}
+
+type atomCategory struct {
+	Term string `xml:"term,attr"`
+}
+
+type rssDocument struct {
+	XMLName       xml.Name   `xml:"rss"`
+	Version       string     `xml:"version,attr"`
+	AtomNamespace string     `xml:"xmlns:atom,attr"`
+	Channel       rssChannel `xml:"channel"`
+}
+
+type rssChannel struct {
+	Title         string    `xml:"title"`
+	Link          string    `xml:"link"`
+	Description   string    `xml:"description"`
+	LastBuildDate string    `xml:"lastBuildDate"`
+	Self          *atomLink `xml:"atom:link,omitempty"`
+	Items         []rssItem `xml:"item"`
+}
+
+type rssItem struct {
+	Title       string   `xml:"title"`
+	Link        string   `xml:"link,omitempty"`
+	GUID        rssGUID  `xml:"guid"`
+	PubDate     string   `xml:"pubDate"`
+	Description string   `xml:"description,omitempty"`
+	Categories  []string `xml:"category"`
+}
+
+type rssGUID struct {
+	IsPermaLink bool   `xml:"isPermaLink,attr"`
+	Value       string `xml:",chardata"`
+}
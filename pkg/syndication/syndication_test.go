@@ -0,0 +1,90 @@
+package syndication
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func testFeed() Feed {
+	created := time.Date(2024, 2, 1, 12, 0, 0, 0, time.UTC)
+	updated := time.Date(2024, 2, 4, 12, 0, 0, 0, time.UTC)
+
+	return Feed{
+		ID:      "urn:uuid:2bc3e1d4-6a52-4d4a-9d39-9b37a4dcb0a1",
+		Title:   "Aave",
+		Self:    "https://api.example.com/v1/daos/2bc3e1d4-6a52-4d4a-9d39-9b37a4dcb0a1/feed.atom",
+		Author:  "Goverland",
+		Updated: updated,
+		Entries: []Entry{{
+			ID:         "urn:uuid:7d1f6c62-3f7b-4f6e-9d0a-2b1c3d4e5f60",
+			Title:      "Voting ended: Risk & rates",
+			Link:       "https://snapshot.org/#/aave.eth/proposal/0x1",
+			Summary:    "Voting ended at 2024-02-04 12:00 UTC",
+			Categories: []string{"proposal"},
+			Published:  created,
+			Updated:    updated,
+		}},
+	}
+}
+
+func TestWriteAtom(t *testing.T) {
+	var buf strings.Builder
+	if err := WriteAtom(&buf, testFeed()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := `<?xml version="1.0" encoding="UTF-8"?>
+<feed xmlns="http://www.w3.org/2005/Atom">
+  <id>urn:uuid:2bc3e1d4-6a52-4d4a-9d39-9b37a4dcb0a1</id>
+  <title>Aave</title>
+  <updated>2024-02-04T12:00:00Z</updated>
+  <link rel="self" type="application/atom+xml" href="https://api.example.com/v1/daos/2bc3e1d4-6a52-4d4a-9d39-9b37a4dcb0a1/feed.atom"></link>
+  <author>
+    <name>Goverland</name>
+  </author>
+  <entry>
+    <id>urn:uuid:7d1f6c62-3f7b-4f6e-9d0a-2b1c3d4e5f60</id>
+    <title>Voting ended: Risk &amp; rates</title>
+    <updated>2024-02-04T12:00:00Z</updated>
+    <published>2024-02-01T12:00:00Z</published>
+    <link rel="alternate" href="https://snapshot.org/#/aave.eth/proposal/0x1"></link>
+    <category term="proposal"></category>
+    <summary>Voting ended at 2024-02-04 12:00 UTC</summary>
+  </entry>
+</feed>
+`
+	if got := buf.String(); got != want {
+		t.Errorf("WriteAtom() =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestWriteRSS(t *testing.T) {
+	var buf strings.Builder
+	if err := WriteRSS(&buf, testFeed()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := `<?xml version="1.0" encoding="UTF-8"?>
+<rss version="2.0" xmlns:atom="http://www.w3.org/2005/Atom">
+  <channel>
+    <title>Aave</title>
+    <link>https://api.example.com/v1/daos/2bc3e1d4-6a52-4d4a-9d39-9b37a4dcb0a1/feed.atom</link>
+    <description></description>
+    <lastBuildDate>Sun, 04 Feb 2024 12:00:00 +0000</lastBuildDate>
+    <atom:link rel="self" type="application/rss+xml" href="https://api.example.com/v1/daos/2bc3e1d4-6a52-4d4a-9d39-9b37a4dcb0a1/feed.atom"></atom:link>
+    <item>
+      <title>Voting ended: Risk &amp; rates</title>
+      <link>https://snapshot.org/#/aave.eth/proposal/0x1</link>
+      <guid isPermaLink="false">urn:uuid:7d1f6c62-3f7b-4f6e-9d0a-2b1c3d4e5f60</guid>
+      <pubDate>Sun, 04 Feb 2024 12:00:00 +0000</pubDate>
+      <description>Voting ended at 2024-02-04 12:00 UTC</description>
+      <category>proposal</category>
+    </item>
+  </channel>
+</rss>
+`
+	if got := buf.String(); got != want {
+		t.Errorf("WriteRSS() =\n%s\nwant\n%s", got, want)
+	}
+}
@@ -0,0 +1,100 @@
+// Package ttlcache is an in-memory key-value cache with expiring items.
+package ttlcache
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+type item[K comparable, V any] struct {
+	key       K
+	value     V
+	expiresAt time.Time
+}
+
+type Cache[K comparable, V any] struct {
+	ttl        time.Duration
+	maxEntries int
+	now        func() time.Time
+
+	mu    sync.Mutex
+	items map[K]*list.Element
+	// order keeps the items from the oldest write, all items share the ttl, so they expire in this order
+	order *list.List
+}
+
+func New[K comparable, V any](ttl time.Duration) *Cache[K, V] {
+	return NewBounded[K, V](ttl, 0)
+}
+
+// NewBounded creates the cache keeping at most maxEntries items, the oldest items are evicted first to store
+// the new ones. Zero maxEntries means no limit.
+func NewBounded[K comparable, V any](ttl time.Duration, maxEntries int) *Cache[K, V] {
+	return &Cache[K, V]{
+		ttl:        ttl,
+		maxEntries: maxEntries,
+		now:        time.Now,
+		items:      make(map[K]*list.Element),
+		order:      list.New(),
+	}
+}
+
+// Set stores the value for the ttl of the cache, expired items are purged on every write.
+func (c *Cache[K, V]) Set(key K, value V) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.now()
+	for front := c.order.Front(); front != nil && now.After(front.Value.(*item[K, V]).expiresAt); front = c.order.Front() {
+		c.remove(front)
+	}
+
+	if el, ok := c.items[key]; ok {
+		c.remove(el)
+	}
+	for c.maxEntries > 0 && c.order.Len() >= c.maxEntries {
+		c.remove(c.order.Front())
+	}
+
+	c.items[key] = c.order.PushBack(&item[K, V]{
+		key:       key,
+		value:     value,
+		expiresAt: now.Add(c.ttl),
+	})
+}
+
+func (c *Cache[K, V]) Get(key K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	el, ok := c.items[key]
+	if !ok || c.now().After(el.Value.(*item[K, V]).expiresAt) {
+		var empty V
+
+		return empty, false
+	}
+
+	return el.Value.(*item[K, V]).value, true
+}
+
+func (c *Cache[K, V]) Delete(key K) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if el, ok := c.items[key]; ok {
+		c.remove(el)
+	}
+}
+
+func (c *Cache[K, V]) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return len(c.items)
+}
+
+func (c *Cache[K, V]) remove(el *list.Element) {
+	c.order.Remove(el)
+	delete(c.items, el.Value.(*item[K, V]).key)
+}
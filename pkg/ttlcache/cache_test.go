@@ -0,0 +1,59 @@
+package ttlcache
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCache(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	c := New[string, int](time.Minute)
+	c.now = func() time.Time { return now }
+
+	c.Set("a", 1)
+	if v, ok := c.Get("a"); !ok || v != 1 {
+		t.Fatalf("expected cached value, got %d %t", v, ok)
+	}
+
+	now = now.Add(30 * time.Second)
+	c.Set("b", 2)
+
+	now = now.Add(45 * time.Second)
+	if _, ok := c.Get("a"); ok {
+		t.Errorf("expected the value to expire")
+	}
+	if v, ok := c.Get("b"); !ok || v != 2 {
+		t.Errorf("expected the value to be alive, got %d %t", v, ok)
+	}
+
+	// the expired item is purged on the next write
+	c.Set("c", 3)
+	if c.Len() != 2 {
+		t.Errorf("expected expired items to be purged, got %d items", c.Len())
+	}
+
+	c.Delete("b")
+	if _, ok := c.Get("b"); ok {
+		t.Errorf("expected the value to be deleted")
+	}
+}
+
+func TestBoundedCache(t *testing.T) {
+	c := NewBounded[string, int](time.Minute, 2)
+
+	c.Set("a", 1)
+	c.Set("b", 2)
+	c.Set("a", 3)
+	c.Set("c", 4)
+
+	// "b" is the oldest write after "a" was overwritten
+	if _, ok := c.Get("b"); ok {
+		t.Errorf("expected the oldest value to be evicted")
+	}
+	if v, ok := c.Get("a"); !ok || v != 3 {
+		t.Errorf("expected the overwritten value to be kept, got %d %t", v, ok)
+	}
+	if c.Len() != 2 {
+		t.Errorf("expected %d items, got %d", 2, c.Len())
+	}
+}
@@ -11,9 +11,9 @@ set -e
 # create directory if not exists
 mkdir -p $2
 
-# remove previously generated .pb.go files
-find $2 -type f -name "*.pb.go" | xargs -r -L1 rm
+# remove previously generated .pb.go and .pb.gw.go files
+find $2 -type f \( -name "*.pb.go" -o -name "*.pb.gw.go" \) | xargs -r -L1 rm
 
-protoc --proto_path=$1 --go_out=$2 --go-grpc_out=$2 $3 --go_opt=paths=source_relative --go-grpc_opt=paths=source_relative
+protoc --proto_path=$1 --proto_path=third_party --go_out=$2 --go-grpc_out=$2 --grpc-gateway_out=$2 $3 --go_opt=paths=source_relative --go-grpc_opt=paths=source_relative --grpc-gateway_opt=paths=source_relative
 
 echo "Files '$3' were compiled"
@@ -7,6 +7,7 @@
 package storage
 
 import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
@@ -214,7 +215,7 @@ var File_dao_proto protoreflect.FileDescriptor
 
 const file_dao_proto_rawDesc = "" +
 	"\n" +
-	"\tdao.proto\x12\astorage\x1a\x1fgoogle/protobuf/timestamp.proto\"'\n" +
+	"\tdao.proto\x12\astorage\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"'\n" +
 	"\x0eDaoByIDRequest\x12\x15\n" +
 	"\x06dao_id\x18\x01 \x01(\tR\x05daoId\"\x98\x02\n" +
 	"\aDaoInfo\x12\x0e\n" +
@@ -229,9 +230,9 @@ const file_dao_proto_rawDesc = "" +
 	"\bverified\x18\a \x01(\bR\bverified\x12)\n" +
 	"\x10popularity_index\x18\b \x01(\x01R\x0fpopularityIndex\"5\n" +
 	"\x0fDaoByIDResponse\x12\"\n" +
-	"\x03dao\x18\x01 \x01(\v2\x10.storage.DaoInfoR\x03dao2C\n" +
-	"\x03Dao\x12<\n" +
-	"\aGetByID\x12\x17.storage.DaoByIDRequest\x1a\x18.storage.DaoByIDResponseB\vZ\t.;storageb\x06proto3"
+	"\x03dao\x18\x01 \x01(\v2\x10.storage.DaoInfoR\x03dao2f\n" +
+	"\x03Dao\x12_\n" +
+	"\aGetByID\x12\x17.storage.DaoByIDRequest\x1a\x18.storage.DaoByIDResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/gateway/v1/daos/{dao_id}B\vZ\t.;storageb\x06proto3"
 
 var (
 	file_dao_proto_rawDescOnce sync.Once
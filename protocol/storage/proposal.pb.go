@@ -7,6 +7,7 @@
 package storage
 
 import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
@@ -132,6 +133,7 @@ type ProposalInfo struct {
 	OriginalCreatedAt *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=original_created_at,json=originalCreatedAt,proto3" json:"original_created_at,omitempty"`
 	VotingStartedAt   *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=voting_started_at,json=votingStartedAt,proto3" json:"voting_started_at,omitempty"`
 	VotingEndedAt     *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=voting_ended_at,json=votingEndedAt,proto3" json:"voting_ended_at,omitempty"`
+	AuthorEnsName     string                 `protobuf:"bytes,17,opt,name=author_ens_name,json=authorEnsName,proto3" json:"author_ens_name,omitempty"`
 	unknownFields     protoimpl.UnknownFields
 	sizeCache         protoimpl.SizeCache
 }
@@ -271,6 +273,13 @@ func (x *ProposalInfo) GetVotingEndedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *ProposalInfo) GetAuthorEnsName() string {
+	if x != nil {
+		return x.AuthorEnsName
+	}
+	return ""
+}
+
 type Timeline struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Action        string                 `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
@@ -583,10 +592,10 @@ var File_proposal_proto protoreflect.FileDescriptor
 
 const file_proposal_proto_rawDesc = "" +
 	"\n" +
-	"\x0eproposal.proto\x12\astorage\x1a\x1fgoogle/protobuf/timestamp.proto\"6\n" +
+	"\x0eproposal.proto\x12\astorage\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"6\n" +
 	"\x13ProposalByIDRequest\x12\x1f\n" +
 	"\vproposal_id\x18\x01 \x01(\tR\n" +
-	"proposalId\"\xd2\x04\n" +
+	"proposalId\"\xfa\x04\n" +
 	"\fProposalInfo\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x129\n" +
 	"\n" +
@@ -605,7 +614,8 @@ const file_proposal_proto_rawDesc = "" +
 	"\achoices\x18\r \x03(\tR\achoices\x12J\n" +
 	"\x13original_created_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\x11originalCreatedAt\x12F\n" +
 	"\x11voting_started_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\x0fvotingStartedAt\x12B\n" +
-	"\x0fvoting_ended_at\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampR\rvotingEndedAt\"]\n" +
+	"\x0fvoting_ended_at\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampR\rvotingEndedAt\x12&\n" +
+	"\x0fauthor_ens_name\x18\x11 \x01(\tR\rauthorEnsName\"]\n" +
 	"\bTimeline\x12\x16\n" +
 	"\x06action\x18\x01 \x01(\tR\x06action\x129\n" +
 	"\n" +
@@ -638,10 +648,10 @@ const file_proposal_proto_rawDesc = "" +
 	"\x11ProposalInfoLevel\x12#\n" +
 	"\x1fPROPOSAL_INFO_LEVEL_UNSPECIFIED\x10\x00\x12\x1c\n" +
 	"\x18PROPOSAL_INFO_LEVEL_FULL\x10\x01\x12\x1d\n" +
-	"\x19PROPOSAL_INFO_LEVEL_SHORT\x10\x022\xa6\x01\n" +
-	"\bProposal\x12F\n" +
-	"\aGetByID\x12\x1c.storage.ProposalByIDRequest\x1a\x1d.storage.ProposalByIDResponse\x12R\n" +
-	"\vGetByFilter\x12 .storage.ProposalByFilterRequest\x1a!.storage.ProposalByFilterResponseB\vZ\t.;storageb\x06proto3"
+	"\x19PROPOSAL_INFO_LEVEL_SHORT\x10\x022\xf2\x01\n" +
+	"\bProposal\x12s\n" +
+	"\aGetByID\x12\x1c.storage.ProposalByIDRequest\x1a\x1d.storage.ProposalByIDResponse\"+\x82\xd3\xe4\x93\x02%\x12#/gateway/v1/proposals/{proposal_id}\x12q\n" +
+	"\vGetByFilter\x12 .storage.ProposalByFilterRequest\x1a!.storage.ProposalByFilterResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/gateway/v1/proposalsB\vZ\t.;storageb\x06proto3"
 
 var (
 	file_proposal_proto_rawDescOnce sync.Once